@@ -0,0 +1,58 @@
+// Package cache provides an abstract Cache interface shared by the cache
+// implementations, together with the types used to configure and observe them.
+//
+// A cache is a bounded key/value store that evicts entries once its capacity
+// is exhausted. The capacity is measured either in number of entries or in the
+// total cost reported by a CostFunc.
+//
+// Reference: https://en.wikipedia.org/wiki/Cache_replacement_policies
+package cache
+
+import (
+	"time"
+)
+
+// Cache interface that all caches implement
+type Cache[K comparable, V any] interface {
+	Put(key K, value V)
+	PutWithTTL(key K, value V, ttl time.Duration)
+	Get(key K) (V, bool)
+	Peek(key K) (V, bool)
+	Remove(key K) bool
+	Contains(key K) bool
+	Keys() []K
+	GetSize() int
+	GetCost() int
+	IsEmpty() bool
+	Purge() int
+	Stats() Stats
+	Clear()
+	String() string
+}
+
+// CostFunc returns the cost of storing value under key.
+// The cost of an entry is computed once, when it is put into the cache.
+// A negative cost counts as 0.
+type CostFunc[K comparable, V any] func(key K, value V) int
+
+// EvictFunc is called with every entry that leaves the cache because the
+// cache ran out of capacity or because the entry expired.
+type EvictFunc[K comparable, V any] func(key K, value V)
+
+// Stats holds the hit/miss counters of a cache
+type Stats struct {
+	Hits        uint64
+	Misses      uint64
+	Evictions   uint64
+	Expirations uint64
+}
+
+// HitRatio returns the fraction of lookups that were hits,
+// or 0 if there was no lookup yet
+func (s Stats) HitRatio() float64 {
+	lookups := s.Hits + s.Misses
+	if lookups == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(lookups)
+}
@@ -0,0 +1,410 @@
+// Package lfu implements a least frequently used cache.
+//
+// Entries are grouped in buckets of equal access frequency. The buckets form a
+// doubly linked list ordered by frequency and every bucket holds a doubly
+// linked list of its entries ordered by recency, so Get, Put and Remove are all
+// O(1). When the cache runs out of capacity the least frequently used entries
+// are evicted first, ties are broken by evicting the least recently used one.
+//
+// Reference: http://dhruvbird.com/lfu.pdf
+package lfu
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/TranThang-2804/golangds/cache"
+)
+
+// entry is a single key/value pair in a frequency bucket
+type entry[K comparable, V any] struct {
+	key       K
+	value     V
+	cost      int
+	expiresAt time.Time
+	bucket    *bucket[K, V]
+	prev      *entry[K, V]
+	next      *entry[K, V]
+}
+
+// bucket holds every entry accessed exactly frequency times,
+// head is the most recently used entry of the bucket and last the least
+type bucket[K comparable, V any] struct {
+	frequency int
+	head      *entry[K, V]
+	last      *entry[K, V]
+	prev      *bucket[K, V]
+	next      *bucket[K, V]
+}
+
+// Cache struct, head is the bucket with the lowest frequency
+type Cache[K comparable, V any] struct {
+	items        map[K]*entry[K, V]
+	head         *bucket[K, V]
+	capacity     int
+	cost         int
+	costFunction cache.CostFunc[K, V]
+	ttl          time.Duration
+	onEvict      cache.EvictFunc[K, V]
+	stats        cache.Stats
+	now          func() time.Time
+}
+
+// New creates an empty cache holding at most capacity entries
+func New[K comparable, V any](capacity int) *Cache[K, V] {
+	return NewWithCost[K, V](capacity, nil)
+}
+
+// NewWithCost creates an empty cache whose entries may cost at most capacity
+// in total, the cost of every entry is given by costFunction.
+// A nil costFunction gives every entry a cost of 1, a negative cost counts as 0.
+func NewWithCost[K comparable, V any](capacity int, costFunction cache.CostFunc[K, V]) *Cache[K, V] {
+	return &Cache[K, V]{
+		items:        make(map[K]*entry[K, V]),
+		capacity:     capacity,
+		costFunction: costFunction,
+		now:          time.Now,
+	}
+}
+
+// SetTTL sets the time to live given to entries stored with Put,
+// a ttl of 0 means those entries never expire
+func (c *Cache[K, V]) SetTTL(ttl time.Duration) {
+	c.ttl = ttl
+}
+
+// SetOnEvict registers the function called for every evicted or expired entry
+func (c *Cache[K, V]) SetOnEvict(onEvict cache.EvictFunc[K, V]) {
+	c.onEvict = onEvict
+}
+
+// Put stores the value under key, overwriting an existing entry counts as an access.
+// An entry whose cost exceeds the capacity of the cache is not stored, and
+// the entry it would overwrite is evicted.
+func (c *Cache[K, V]) Put(key K, value V) {
+	c.PutWithTTL(key, value, c.ttl)
+}
+
+// PutWithTTL stores the value under key, expiring it after ttl.
+// A ttl of 0 or less means the entry never expires.
+func (c *Cache[K, V]) PutWithTTL(key K, value V, ttl time.Duration) {
+	cost := 1
+	if c.costFunction != nil {
+		cost = max(c.costFunction(key, value), 0)
+	}
+
+	if cost > c.capacity {
+		// The entry it would overwrite leaves the cache for lack of capacity
+		if e, ok := c.items[key]; ok {
+			c.stats.Evictions++
+			c.evict(e)
+		}
+		return
+	}
+
+	var expiresAt time.Time
+	if ttl > 0 {
+		expiresAt = c.now().Add(ttl)
+	}
+
+	if e, ok := c.items[key]; ok {
+		c.cost += cost - e.cost
+		e.value, e.cost, e.expiresAt = value, cost, expiresAt
+		c.increment(e)
+		c.evictOverflow(e)
+		return
+	}
+
+	// Make room before inserting so that the new entry, whose frequency
+	// is the lowest possible, is not evicted right away
+	c.cost += cost
+	c.evictOverflow(nil)
+
+	e := &entry[K, V]{key: key, value: value, cost: cost, expiresAt: expiresAt}
+	c.items[key] = e
+	if c.head == nil || c.head.frequency != 1 {
+		c.insertBucketAfter(nil, 1)
+	}
+	c.head.pushFront(e)
+}
+
+// Get returns the value stored under key and increments its frequency
+// return true if the value is found else return false
+func (c *Cache[K, V]) Get(key K) (V, bool) {
+	e, ok := c.items[key]
+	if !ok {
+		c.stats.Misses++
+		var zeroValue V
+		return zeroValue, false
+	}
+
+	if c.expired(e) {
+		c.stats.Misses++
+		c.stats.Expirations++
+		c.evict(e)
+		var zeroValue V
+		return zeroValue, false
+	}
+
+	c.stats.Hits++
+	c.increment(e)
+	return e.value, true
+}
+
+// Peek returns the value stored under key without updating
+// the frequency of the entry or the statistics
+func (c *Cache[K, V]) Peek(key K) (V, bool) {
+	e, ok := c.items[key]
+	if !ok || c.expired(e) {
+		var zeroValue V
+		return zeroValue, false
+	}
+	return e.value, true
+}
+
+// Frequency returns how many times the entry stored under key was accessed
+// return true if the entry is found else return false
+func (c *Cache[K, V]) Frequency(key K) (int, bool) {
+	e, ok := c.items[key]
+	if !ok || c.expired(e) {
+		return 0, false
+	}
+	return e.bucket.frequency, true
+}
+
+// Remove the entry stored under key, the eviction callback is not called
+// return true if the entry is removed else return false
+func (c *Cache[K, V]) Remove(key K) bool {
+	e, ok := c.items[key]
+	if !ok {
+		return false
+	}
+	c.removeEntry(e)
+	return true
+}
+
+// Check if an unexpired entry is stored under key
+func (c *Cache[K, V]) Contains(key K) bool {
+	e, ok := c.items[key]
+	return ok && !c.expired(e)
+}
+
+// Keys returns the keys of the unexpired entries from the most frequently
+// used to the least frequently used, the most recent first among equals
+func (c *Cache[K, V]) Keys() []K {
+	keys := make([]K, 0, len(c.items))
+	var lastBucket *bucket[K, V]
+	for b := c.head; b != nil; b = b.next {
+		lastBucket = b
+	}
+	for b := lastBucket; b != nil; b = b.prev {
+		for current := b.head; current != nil; current = current.next {
+			if !c.expired(current) {
+				keys = append(keys, current.key)
+			}
+		}
+	}
+	return keys
+}
+
+// GetSize returns the number of entries, expired entries
+// that were not purged yet included
+func (c *Cache[K, V]) GetSize() int {
+	return len(c.items)
+}
+
+// GetCost returns the total cost of the entries in the cache
+func (c *Cache[K, V]) GetCost() int {
+	return c.cost
+}
+
+// GetCapacity returns the capacity of the cache
+func (c *Cache[K, V]) GetCapacity() int {
+	return c.capacity
+}
+
+// Check if the cache is empty
+func (c *Cache[K, V]) IsEmpty() bool {
+	return len(c.items) == 0
+}
+
+// Purge removes every expired entry and returns how many were removed
+func (c *Cache[K, V]) Purge() int {
+	purged := 0
+	for b := c.head; b != nil; {
+		nextBucket := b.next
+		for current := b.head; current != nil; {
+			next := current.next
+			if c.expired(current) {
+				c.stats.Expirations++
+				c.evict(current)
+				purged++
+			}
+			current = next
+		}
+		b = nextBucket
+	}
+	return purged
+}
+
+// Stats returns a snapshot of the hit/miss counters
+func (c *Cache[K, V]) Stats() cache.Stats {
+	return c.stats
+}
+
+// ResetStats sets every counter back to 0
+func (c *Cache[K, V]) ResetStats() {
+	c.stats = cache.Stats{}
+}
+
+// Clear removes every entry, the eviction callback is not called
+func (c *Cache[K, V]) Clear() {
+	c.items = make(map[K]*entry[K, V])
+	c.head = nil
+	c.cost = 0
+}
+
+// Clone returns a new cache with the entries of the cache with the same
+// frequencies and order of recency, its settings and its statistics.
+// Expired entries are cloned too, they are dropped when next looked up or
+// purged.
+func (c *Cache[K, V]) Clone() *Cache[K, V] {
+	clone := *c
+	clone.items = make(map[K]*entry[K, V], len(c.items))
+	clone.head = nil
+	var last *bucket[K, V]
+	for b := c.head; b != nil; b = b.next {
+		last = clone.insertBucketAfter(last, b.frequency)
+		for current := b.last; current != nil; current = current.prev {
+			e := &entry[K, V]{key: current.key, value: current.value, cost: current.cost, expiresAt: current.expiresAt}
+			clone.items[e.key] = e
+			last.pushFront(e)
+		}
+	}
+	return &clone
+}
+
+// Return the string representation of the cache
+func (c *Cache[K, V]) String() string {
+	str := "LFUCache\n"
+	values := []string{}
+	for b := c.head; b != nil; b = b.next {
+		for current := b.head; current != nil; current = current.next {
+			values = append(values, fmt.Sprintf("%v:%v(%d)", current.key, current.value, b.frequency))
+		}
+	}
+	str += strings.Join(values, ", ")
+	return str
+}
+
+// expired reports whether the entry has outlived its ttl
+func (c *Cache[K, V]) expired(e *entry[K, V]) bool {
+	return !e.expiresAt.IsZero() && !c.now().Before(e.expiresAt)
+}
+
+// evictOverflow evicts entries until the cache fits its capacity,
+// keep is never evicted
+func (c *Cache[K, V]) evictOverflow(keep *entry[K, V]) {
+	for c.cost > c.capacity {
+		victim := c.head.last
+		if victim == keep {
+			victim = victim.prev
+			if victim == nil {
+				victim = c.head.next.last
+			}
+		}
+		c.stats.Evictions++
+		c.evict(victim)
+	}
+}
+
+// evict removes the entry and notifies the eviction callback
+func (c *Cache[K, V]) evict(e *entry[K, V]) {
+	c.removeEntry(e)
+	if c.onEvict != nil {
+		c.onEvict(e.key, e.value)
+	}
+}
+
+// removeEntry unlinks the entry from its bucket and the index
+func (c *Cache[K, V]) removeEntry(e *entry[K, V]) {
+	b := e.bucket
+	b.unlink(e)
+	if b.head == nil {
+		c.removeBucket(b)
+	}
+	delete(c.items, e.key)
+	c.cost -= e.cost
+}
+
+// increment moves the entry to the bucket of the next frequency
+func (c *Cache[K, V]) increment(e *entry[K, V]) {
+	current := e.bucket
+	next := current.next
+	if next == nil || next.frequency != current.frequency+1 {
+		next = c.insertBucketAfter(current, current.frequency+1)
+	}
+	current.unlink(e)
+	next.pushFront(e)
+	if current.head == nil {
+		c.removeBucket(current)
+	}
+}
+
+// insertBucketAfter creates an empty bucket after b,
+// or at the head of the bucket list if b is nil
+func (c *Cache[K, V]) insertBucketAfter(b *bucket[K, V], frequency int) *bucket[K, V] {
+	newBucket := &bucket[K, V]{frequency: frequency, prev: b}
+	if b == nil {
+		newBucket.next = c.head
+		c.head = newBucket
+	} else {
+		newBucket.next = b.next
+		b.next = newBucket
+	}
+	if newBucket.next != nil {
+		newBucket.next.prev = newBucket
+	}
+	return newBucket
+}
+
+func (c *Cache[K, V]) removeBucket(b *bucket[K, V]) {
+	if b.prev == nil {
+		c.head = b.next
+	} else {
+		b.prev.next = b.next
+	}
+	if b.next != nil {
+		b.next.prev = b.prev
+	}
+	b.prev = nil
+	b.next = nil
+}
+
+func (b *bucket[K, V]) pushFront(e *entry[K, V]) {
+	e.bucket = b
+	e.prev = nil
+	e.next = b.head
+	if b.head == nil {
+		b.last = e
+	} else {
+		b.head.prev = e
+	}
+	b.head = e
+}
+
+func (b *bucket[K, V]) unlink(e *entry[K, V]) {
+	if e.prev == nil {
+		b.head = e.next
+	} else {
+		e.prev.next = e.next
+	}
+	if e.next == nil {
+		b.last = e.prev
+	} else {
+		e.next.prev = e.prev
+	}
+	e.prev = nil
+	e.next = nil
+}
@@ -0,0 +1,304 @@
+package lfu
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/TranThang-2804/golangds/cache"
+)
+
+// fakeClock replaces time.Now so that expiry can be tested without sleeping
+type fakeClock struct {
+	current time.Time
+}
+
+func (f *fakeClock) now() time.Time {
+	return f.current
+}
+
+func (f *fakeClock) advance(d time.Duration) {
+	f.current = f.current.Add(d)
+}
+
+func TestCacheImplementsInterface(t *testing.T) {
+	var _ cache.Cache[string, int] = New[string, int](1)
+}
+
+func TestCachePutAndGet(t *testing.T) {
+	c := New[string, int](2)
+	if actualValue := c.IsEmpty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	c.Put("a", 1)
+	c.Put("b", 2)
+	if actualValue := c.GetSize(); actualValue != 2 {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+	if actualValue, ok := c.Get("a"); actualValue != 1 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	if actualValue, ok := c.Get("c"); actualValue != 0 || ok {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+	c.Put("a", 10)
+	if actualValue, ok := c.Get("a"); actualValue != 10 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 10)
+	}
+	if actualValue, ok := c.Frequency("a"); actualValue != 4 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 4)
+	}
+	if actualValue, ok := c.Frequency("b"); actualValue != 1 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+}
+
+func TestCacheEvictsLeastFrequentlyUsed(t *testing.T) {
+	c := New[string, int](3)
+	evicted := []string{}
+	c.SetOnEvict(func(key string, value int) {
+		evicted = append(evicted, key)
+	})
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Put("c", 3)
+	c.Get("a")
+	c.Get("a")
+	c.Get("c")
+	c.Put("d", 4)
+	if actualValue, expectedValue := evicted, []string{"b"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	// The fresh d now has the lowest frequency and goes next
+	c.Put("e", 5)
+	if actualValue, expectedValue := evicted, []string{"b", "d"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := c.Keys(), []string{"a", "c", "e"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestCacheEvictsLeastRecentlyUsedAmongEquals(t *testing.T) {
+	c := New[string, int](2)
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Get("b")
+	c.Get("a")
+	c.Put("c", 3)
+	if actualValue, expectedValue := c.Keys(), []string{"a", "c"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestCacheCost(t *testing.T) {
+	c := NewWithCost[string, string](10, func(key string, value string) int {
+		return len(value)
+	})
+	evicted := []string{}
+	c.SetOnEvict(func(key string, value string) {
+		evicted = append(evicted, key)
+	})
+	c.Put("a", "aaaa")
+	c.Put("b", "bbbb")
+	c.Get("a")
+	c.Put("c", "cccccc")
+	if actualValue, expectedValue := evicted, []string{"b"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := c.GetCost(); actualValue != 10 {
+		t.Errorf("Got %v expected %v", actualValue, 10)
+	}
+	// Growing a hot entry evicts the others but never the entry itself
+	c.Put("a", "aaaaaaaaaa")
+	if actualValue, expectedValue := evicted, []string{"b", "c"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := c.Keys(), []string{"a"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	c.Put("d", "ddddddddddd") // too big, not stored
+	if actualValue := c.Contains("d"); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	c.Put("a", "aaaaaaaaaaa") // too big, evicts the old value
+	if actualValue := c.Contains("a"); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue, expectedValue := evicted, []string{"b", "c", "a"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := c.GetCost(); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+}
+
+func TestCacheNegativeCost(t *testing.T) {
+	c := NewWithCost[string, int](2, func(key string, value int) int {
+		return value
+	})
+	c.Put("a", -5)
+	c.Put("b", 1)
+	c.Put("c", 1)
+	c.Put("d", 1)
+	// The negative cost counts as 0, it does not make room for more
+	if actualValue := c.GetCost(); actualValue != 2 {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+	if actualValue, expectedValue := len(c.Keys()), 2; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestCacheTTL(t *testing.T) {
+	clock := &fakeClock{current: time.Unix(0, 0)}
+	c := New[string, int](10)
+	c.now = clock.now
+	expired := []string{}
+	c.SetOnEvict(func(key string, value int) {
+		expired = append(expired, key)
+	})
+	c.SetTTL(time.Minute)
+	c.Put("a", 1)
+	c.PutWithTTL("b", 2, time.Hour)
+	c.PutWithTTL("c", 3, 0)
+	c.Get("b")
+
+	clock.advance(time.Minute)
+	if actualValue, ok := c.Get("a"); actualValue != 0 || ok {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+	if actualValue, expectedValue := expired, []string{"a"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	clock.advance(time.Hour)
+	if actualValue := c.Purge(); actualValue != 1 {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	if actualValue, expectedValue := c.Keys(), []string{"c"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := c.Stats(), (cache.Stats{Hits: 1, Misses: 1, Expirations: 2}); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestCacheRemoveAndClear(t *testing.T) {
+	c := New[string, int](3)
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Get("b")
+	if actualValue := c.Remove("b"); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := c.Remove("b"); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue, expectedValue := c.Keys(), []string{"a"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	c.Clear()
+	if actualValue := c.IsEmpty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	c.Put("c", 3)
+	if actualValue, ok := c.Frequency("c"); actualValue != 1 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+}
+
+func TestCacheString(t *testing.T) {
+	c := New[string, int](2)
+	c.Put("a", 1)
+	if !strings.HasPrefix(c.String(), "LFUCache") {
+		t.Errorf("String should start with container name")
+	}
+}
+
+func TestCacheClone(t *testing.T) {
+	c := New[string, int](3)
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Put("c", 3)
+	c.Get("a")
+	c.Get("a")
+	c.Get("c")
+	clone := c.Clone()
+	if actualValue, expectedValue := clone.Keys(), []string{"a", "c", "b"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := clone.String(), c.String(); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// The clone counts the frequencies of its own entries
+	clone.Get("b")
+	clone.Get("b")
+	clone.Get("b")
+	clone.Put("d", 4)
+	if actualValue, expectedValue := clone.Keys(), []string{"b", "a", "d"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := c.Keys(), []string{"a", "c", "b"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, ok := c.Frequency("b"); actualValue != 1 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+}
+
+func benchmarkPut(b *testing.B, c *Cache[int, int], size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			c.Put(n, n)
+		}
+	}
+}
+
+func benchmarkGet(b *testing.B, c *Cache[int, int], size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			c.Get(n)
+		}
+	}
+}
+
+func BenchmarkLFUCachePut1000(b *testing.B) {
+	b.StopTimer()
+	size := 1000
+	c := New[int, int](size / 2)
+	b.StartTimer()
+	benchmarkPut(b, c, size)
+}
+
+func BenchmarkLFUCachePut100000(b *testing.B) {
+	b.StopTimer()
+	size := 100000
+	c := New[int, int](size / 2)
+	b.StartTimer()
+	benchmarkPut(b, c, size)
+}
+
+func BenchmarkLFUCacheGet1000(b *testing.B) {
+	b.StopTimer()
+	size := 1000
+	c := New[int, int](size)
+	for n := 0; n < size; n++ {
+		c.Put(n, n)
+	}
+	b.StartTimer()
+	benchmarkGet(b, c, size)
+}
+
+func BenchmarkLFUCacheGet100000(b *testing.B) {
+	b.StopTimer()
+	size := 100000
+	c := New[int, int](size)
+	for n := 0; n < size; n++ {
+		c.Put(n, n)
+	}
+	b.StartTimer()
+	benchmarkGet(b, c, size)
+}
@@ -0,0 +1,313 @@
+// Package lru implements a least recently used cache.
+//
+// Entries are kept in an intrusive doubly linked list ordered by recency and
+// indexed by a map, so Get, Put and Remove are all O(1). When the cache runs
+// out of capacity the least recently used entries are evicted first.
+//
+// Reference: https://en.wikipedia.org/wiki/Cache_replacement_policies#LRU
+package lru
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/TranThang-2804/golangds/cache"
+)
+
+// entry is a single key/value pair in the recency list
+type entry[K comparable, V any] struct {
+	key       K
+	value     V
+	cost      int
+	expiresAt time.Time
+	prev      *entry[K, V]
+	next      *entry[K, V]
+}
+
+// Cache struct, head is the most recently used entry and last the least
+type Cache[K comparable, V any] struct {
+	items        map[K]*entry[K, V]
+	head         *entry[K, V]
+	last         *entry[K, V]
+	capacity     int
+	cost         int
+	costFunction cache.CostFunc[K, V]
+	ttl          time.Duration
+	onEvict      cache.EvictFunc[K, V]
+	stats        cache.Stats
+	now          func() time.Time
+}
+
+// New creates an empty cache holding at most capacity entries
+func New[K comparable, V any](capacity int) *Cache[K, V] {
+	return NewWithCost[K, V](capacity, nil)
+}
+
+// NewWithCost creates an empty cache whose entries may cost at most capacity
+// in total, the cost of every entry is given by costFunction.
+// A nil costFunction gives every entry a cost of 1, a negative cost counts as 0.
+func NewWithCost[K comparable, V any](capacity int, costFunction cache.CostFunc[K, V]) *Cache[K, V] {
+	return &Cache[K, V]{
+		items:        make(map[K]*entry[K, V]),
+		capacity:     capacity,
+		costFunction: costFunction,
+		now:          time.Now,
+	}
+}
+
+// SetTTL sets the time to live given to entries stored with Put,
+// a ttl of 0 means those entries never expire
+func (c *Cache[K, V]) SetTTL(ttl time.Duration) {
+	c.ttl = ttl
+}
+
+// SetOnEvict registers the function called for every evicted or expired entry
+func (c *Cache[K, V]) SetOnEvict(onEvict cache.EvictFunc[K, V]) {
+	c.onEvict = onEvict
+}
+
+// Put stores the value under key and marks it as the most recently used.
+// An entry whose cost exceeds the capacity of the cache is not stored, and
+// the entry it would overwrite is evicted.
+func (c *Cache[K, V]) Put(key K, value V) {
+	c.PutWithTTL(key, value, c.ttl)
+}
+
+// PutWithTTL stores the value under key, expiring it after ttl.
+// A ttl of 0 or less means the entry never expires.
+func (c *Cache[K, V]) PutWithTTL(key K, value V, ttl time.Duration) {
+	cost := 1
+	if c.costFunction != nil {
+		cost = max(c.costFunction(key, value), 0)
+	}
+
+	if cost > c.capacity {
+		// The entry it would overwrite leaves the cache for lack of capacity
+		if e, ok := c.items[key]; ok {
+			c.stats.Evictions++
+			c.evict(e)
+		}
+		return
+	}
+
+	var expiresAt time.Time
+	if ttl > 0 {
+		expiresAt = c.now().Add(ttl)
+	}
+
+	if e, ok := c.items[key]; ok {
+		c.cost += cost - e.cost
+		e.value, e.cost, e.expiresAt = value, cost, expiresAt
+		c.moveToFront(e)
+	} else {
+		e = &entry[K, V]{key: key, value: value, cost: cost, expiresAt: expiresAt}
+		c.items[key] = e
+		c.cost += cost
+		c.pushFront(e)
+	}
+
+	// The entry just stored is at the head and fits on its own,
+	// so it is never the one evicted here
+	for c.cost > c.capacity {
+		c.stats.Evictions++
+		c.evict(c.last)
+	}
+}
+
+// Get returns the value stored under key and marks it as the most recently used
+// return true if the value is found else return false
+func (c *Cache[K, V]) Get(key K) (V, bool) {
+	e, ok := c.items[key]
+	if !ok {
+		c.stats.Misses++
+		var zeroValue V
+		return zeroValue, false
+	}
+
+	if c.expired(e) {
+		c.stats.Misses++
+		c.stats.Expirations++
+		c.evict(e)
+		var zeroValue V
+		return zeroValue, false
+	}
+
+	c.stats.Hits++
+	c.moveToFront(e)
+	return e.value, true
+}
+
+// Peek returns the value stored under key without updating
+// the recency of the entry or the statistics
+func (c *Cache[K, V]) Peek(key K) (V, bool) {
+	e, ok := c.items[key]
+	if !ok || c.expired(e) {
+		var zeroValue V
+		return zeroValue, false
+	}
+	return e.value, true
+}
+
+// Remove the entry stored under key, the eviction callback is not called
+// return true if the entry is removed else return false
+func (c *Cache[K, V]) Remove(key K) bool {
+	e, ok := c.items[key]
+	if !ok {
+		return false
+	}
+	c.removeEntry(e)
+	return true
+}
+
+// Check if an unexpired entry is stored under key
+func (c *Cache[K, V]) Contains(key K) bool {
+	e, ok := c.items[key]
+	return ok && !c.expired(e)
+}
+
+// Keys returns the keys of the unexpired entries
+// from the most recently used to the least recently used
+func (c *Cache[K, V]) Keys() []K {
+	keys := make([]K, 0, len(c.items))
+	for current := c.head; current != nil; current = current.next {
+		if !c.expired(current) {
+			keys = append(keys, current.key)
+		}
+	}
+	return keys
+}
+
+// GetSize returns the number of entries, expired entries
+// that were not purged yet included
+func (c *Cache[K, V]) GetSize() int {
+	return len(c.items)
+}
+
+// GetCost returns the total cost of the entries in the cache
+func (c *Cache[K, V]) GetCost() int {
+	return c.cost
+}
+
+// GetCapacity returns the capacity of the cache
+func (c *Cache[K, V]) GetCapacity() int {
+	return c.capacity
+}
+
+// Check if the cache is empty
+func (c *Cache[K, V]) IsEmpty() bool {
+	return len(c.items) == 0
+}
+
+// Purge removes every expired entry and returns how many were removed
+func (c *Cache[K, V]) Purge() int {
+	purged := 0
+	for current := c.head; current != nil; {
+		next := current.next
+		if c.expired(current) {
+			c.stats.Expirations++
+			c.evict(current)
+			purged++
+		}
+		current = next
+	}
+	return purged
+}
+
+// Stats returns a snapshot of the hit/miss counters
+func (c *Cache[K, V]) Stats() cache.Stats {
+	return c.stats
+}
+
+// ResetStats sets every counter back to 0
+func (c *Cache[K, V]) ResetStats() {
+	c.stats = cache.Stats{}
+}
+
+// Clear removes every entry, the eviction callback is not called
+func (c *Cache[K, V]) Clear() {
+	c.items = make(map[K]*entry[K, V])
+	c.head = nil
+	c.last = nil
+	c.cost = 0
+}
+
+// Clone returns a new cache with the entries of the cache in the same order
+// of recency, its settings and its statistics. Expired entries are cloned
+// too, they are dropped when next looked up or purged.
+func (c *Cache[K, V]) Clone() *Cache[K, V] {
+	clone := *c
+	clone.items = make(map[K]*entry[K, V], len(c.items))
+	clone.head, clone.last = nil, nil
+	for current := c.last; current != nil; current = current.prev {
+		e := &entry[K, V]{key: current.key, value: current.value, cost: current.cost, expiresAt: current.expiresAt}
+		clone.items[e.key] = e
+		clone.pushFront(e)
+	}
+	return &clone
+}
+
+// Return the string representation of the cache
+func (c *Cache[K, V]) String() string {
+	str := "LRUCache\n"
+	values := []string{}
+	for current := c.head; current != nil; current = current.next {
+		values = append(values, fmt.Sprintf("%v:%v", current.key, current.value))
+	}
+	str += strings.Join(values, ", ")
+	return str
+}
+
+// expired reports whether the entry has outlived its ttl
+func (c *Cache[K, V]) expired(e *entry[K, V]) bool {
+	return !e.expiresAt.IsZero() && !c.now().Before(e.expiresAt)
+}
+
+// evict removes the entry and notifies the eviction callback
+func (c *Cache[K, V]) evict(e *entry[K, V]) {
+	c.removeEntry(e)
+	if c.onEvict != nil {
+		c.onEvict(e.key, e.value)
+	}
+}
+
+// removeEntry unlinks the entry from the list and the index
+func (c *Cache[K, V]) removeEntry(e *entry[K, V]) {
+	c.unlink(e)
+	delete(c.items, e.key)
+	c.cost -= e.cost
+}
+
+func (c *Cache[K, V]) pushFront(e *entry[K, V]) {
+	e.prev = nil
+	e.next = c.head
+	if c.head == nil {
+		c.last = e
+	} else {
+		c.head.prev = e
+	}
+	c.head = e
+}
+
+func (c *Cache[K, V]) unlink(e *entry[K, V]) {
+	if e.prev == nil {
+		c.head = e.next
+	} else {
+		e.prev.next = e.next
+	}
+	if e.next == nil {
+		c.last = e.prev
+	} else {
+		e.next.prev = e.prev
+	}
+	e.prev = nil
+	e.next = nil
+}
+
+func (c *Cache[K, V]) moveToFront(e *entry[K, V]) {
+	if c.head == e {
+		return
+	}
+	c.unlink(e)
+	c.pushFront(e)
+}
@@ -0,0 +1,338 @@
+package lru
+
+import (
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/TranThang-2804/golangds/cache"
+)
+
+// fakeClock replaces time.Now so that expiry can be tested without sleeping
+type fakeClock struct {
+	current time.Time
+}
+
+func (f *fakeClock) now() time.Time {
+	return f.current
+}
+
+func (f *fakeClock) advance(d time.Duration) {
+	f.current = f.current.Add(d)
+}
+
+func TestCacheImplementsInterface(t *testing.T) {
+	var _ cache.Cache[string, int] = New[string, int](1)
+}
+
+func TestCachePutAndGet(t *testing.T) {
+	c := New[string, int](2)
+	if actualValue := c.IsEmpty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	c.Put("a", 1)
+	c.Put("b", 2)
+	if actualValue := c.GetSize(); actualValue != 2 {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+	if actualValue, ok := c.Get("a"); actualValue != 1 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	if actualValue, ok := c.Get("c"); actualValue != 0 || ok {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+	c.Put("a", 10)
+	if actualValue, ok := c.Get("a"); actualValue != 10 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 10)
+	}
+	if actualValue := c.GetSize(); actualValue != 2 {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+}
+
+func TestCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	c := New[string, int](3)
+	evicted := []string{}
+	c.SetOnEvict(func(key string, value int) {
+		evicted = append(evicted, key)
+	})
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Put("c", 3)
+	c.Get("a")
+	c.Put("d", 4)
+	if actualValue, expectedValue := evicted, []string{"b"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := c.Keys(), []string{"d", "a", "c"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	c.Peek("c") // does not touch recency
+	c.Put("e", 5)
+	if actualValue, expectedValue := evicted, []string{"b", "c"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestCacheCost(t *testing.T) {
+	c := NewWithCost[string, string](10, func(key string, value string) int {
+		return len(value)
+	})
+	evicted := []string{}
+	c.SetOnEvict(func(key string, value string) {
+		evicted = append(evicted, key)
+	})
+	c.Put("a", "aaaa")
+	c.Put("b", "bbbb")
+	if actualValue := c.GetCost(); actualValue != 8 {
+		t.Errorf("Got %v expected %v", actualValue, 8)
+	}
+	c.Put("c", "cccccc")
+	if actualValue, expectedValue := evicted, []string{"a"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := c.GetCost(); actualValue != 10 {
+		t.Errorf("Got %v expected %v", actualValue, 10)
+	}
+	c.Put("d", "ddddddddddd") // too big, not stored
+	if actualValue := c.Contains("d"); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	c.Put("c", "cccccccccccc") // too big, evicts the old value
+	if actualValue := c.Contains("c"); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue, expectedValue := evicted, []string{"a", "c"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := c.GetCost(); actualValue != 4 {
+		t.Errorf("Got %v expected %v", actualValue, 4)
+	}
+}
+
+func TestCacheNegativeCost(t *testing.T) {
+	c := NewWithCost[string, int](2, func(key string, value int) int {
+		return value
+	})
+	c.Put("a", -5)
+	c.Put("b", 1)
+	c.Put("c", 1)
+	c.Put("d", 1)
+	// The negative cost counts as 0, it does not make room for more
+	if actualValue := c.GetCost(); actualValue != 2 {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+	if actualValue, expectedValue := c.Contains("b"), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestCacheTTL(t *testing.T) {
+	clock := &fakeClock{current: time.Unix(0, 0)}
+	c := New[string, int](10)
+	c.now = clock.now
+	expired := []string{}
+	c.SetOnEvict(func(key string, value int) {
+		expired = append(expired, key)
+	})
+	c.SetTTL(time.Minute)
+	c.Put("a", 1)
+	c.PutWithTTL("b", 2, time.Hour)
+	c.PutWithTTL("c", 3, 0)
+
+	clock.advance(59 * time.Second)
+	if actualValue, ok := c.Get("a"); actualValue != 1 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	clock.advance(time.Second)
+	if actualValue, ok := c.Get("a"); actualValue != 0 || ok {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+	if actualValue, expectedValue := expired, []string{"a"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	clock.advance(time.Hour)
+	if actualValue := c.Contains("b"); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := c.Purge(); actualValue != 1 {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	if actualValue, expectedValue := c.Keys(), []string{"c"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := c.Stats().Expirations; actualValue != 2 {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+}
+
+func TestCacheStats(t *testing.T) {
+	c := New[int, int](1)
+	c.Put(1, 1)
+	c.Get(1)
+	c.Get(1)
+	c.Get(2)
+	c.Put(2, 2)
+	stats := c.Stats()
+	if actualValue, expectedValue := stats, (cache.Stats{Hits: 2, Misses: 1, Evictions: 1}); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := stats.HitRatio(), 2.0/3.0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	c.ResetStats()
+	if actualValue, expectedValue := c.Stats(), (cache.Stats{}); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestCacheRemoveAndClear(t *testing.T) {
+	c := New[string, int](3)
+	evictions := 0
+	c.SetOnEvict(func(key string, value int) {
+		evictions++
+	})
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Put("c", 3)
+	if actualValue := c.Remove("b"); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := c.Remove("b"); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue, expectedValue := c.Keys(), []string{"c", "a"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	c.Remove("c")
+	c.Remove("a")
+	c.Put("d", 4)
+	if actualValue, expectedValue := c.Keys(), []string{"d"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	c.Clear()
+	if actualValue := c.IsEmpty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := c.GetCost(); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+	if actualValue := evictions; actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+}
+
+func TestCacheSynchronized(t *testing.T) {
+	c := cache.NewSynchronized[int, int](New[int, int](100))
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for n := 0; n < 1000; n++ {
+				c.Put(g*1000+n, n)
+				c.Get(g*1000 + n/2)
+			}
+		}(g)
+	}
+	wg.Wait()
+	if actualValue := c.GetSize(); actualValue != 100 {
+		t.Errorf("Got %v expected %v", actualValue, 100)
+	}
+	if actualValue := c.Stats().Hits + c.Stats().Misses; actualValue != 8000 {
+		t.Errorf("Got %v expected %v", actualValue, 8000)
+	}
+}
+
+func TestCacheString(t *testing.T) {
+	c := New[string, int](2)
+	c.Put("a", 1)
+	if !strings.HasPrefix(c.String(), "LRUCache") {
+		t.Errorf("String should start with container name")
+	}
+}
+
+func TestCacheClone(t *testing.T) {
+	c := New[string, int](3)
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Put("c", 3)
+	c.Get("a")
+	clone := c.Clone()
+	if actualValue, expectedValue := clone.Keys(), []string{"a", "c", "b"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := clone.Stats(), c.Stats(); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// The clone evicts its own least recently used entry
+	clone.Put("d", 4)
+	c.Remove("c")
+	if actualValue, expectedValue := clone.Keys(), []string{"d", "a", "c"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := c.Keys(), []string{"a", "b"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := clone.GetCost(), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func benchmarkPut(b *testing.B, c *Cache[int, int], size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			c.Put(n, n)
+		}
+	}
+}
+
+func benchmarkGet(b *testing.B, c *Cache[int, int], size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			c.Get(n)
+		}
+	}
+}
+
+func BenchmarkLRUCachePut1000(b *testing.B) {
+	b.StopTimer()
+	size := 1000
+	c := New[int, int](size / 2)
+	b.StartTimer()
+	benchmarkPut(b, c, size)
+}
+
+func BenchmarkLRUCachePut100000(b *testing.B) {
+	b.StopTimer()
+	size := 100000
+	c := New[int, int](size / 2)
+	b.StartTimer()
+	benchmarkPut(b, c, size)
+}
+
+func BenchmarkLRUCacheGet1000(b *testing.B) {
+	b.StopTimer()
+	size := 1000
+	c := New[int, int](size)
+	for n := 0; n < size; n++ {
+		c.Put(n, n)
+	}
+	b.StartTimer()
+	benchmarkGet(b, c, size)
+}
+
+func BenchmarkLRUCacheGet100000(b *testing.B) {
+	b.StopTimer()
+	size := 100000
+	c := New[int, int](size)
+	for n := 0; n < size; n++ {
+		c.Put(n, n)
+	}
+	b.StartTimer()
+	benchmarkGet(b, c, size)
+}
@@ -0,0 +1,123 @@
+package cache
+
+import (
+	"sync"
+	"time"
+)
+
+// Synchronized wraps a cache so that it can be used from several goroutines.
+//
+// Every method holds a single mutex, lookups included, because a lookup
+// updates the recency/frequency bookkeeping of the wrapped cache. Eviction
+// callbacks run while the mutex is held and must not call back into the cache.
+type Synchronized[K comparable, V any] struct {
+	mutex sync.Mutex
+	cache Cache[K, V]
+}
+
+// NewSynchronized creates a thread-safe view of the given cache.
+// The wrapped cache must not be used directly afterwards.
+func NewSynchronized[K comparable, V any](cache Cache[K, V]) *Synchronized[K, V] {
+	return &Synchronized[K, V]{cache: cache}
+}
+
+// Put stores the value under key
+func (s *Synchronized[K, V]) Put(key K, value V) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.cache.Put(key, value)
+}
+
+// PutWithTTL stores the value under key, expiring it after ttl
+func (s *Synchronized[K, V]) PutWithTTL(key K, value V, ttl time.Duration) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.cache.PutWithTTL(key, value, ttl)
+}
+
+// Get returns the value stored under key and true if it is found else return false
+func (s *Synchronized[K, V]) Get(key K) (V, bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.cache.Get(key)
+}
+
+// Peek returns the value stored under key without touching the statistics
+// or the eviction order
+func (s *Synchronized[K, V]) Peek(key K) (V, bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.cache.Peek(key)
+}
+
+// Remove the entry stored under key
+// return true if the entry is removed else return false
+func (s *Synchronized[K, V]) Remove(key K) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.cache.Remove(key)
+}
+
+// Contains returns true if an unexpired entry is stored under key
+func (s *Synchronized[K, V]) Contains(key K) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.cache.Contains(key)
+}
+
+// Keys returns the keys of the unexpired entries in eviction order,
+// the entry that would be evicted last comes first
+func (s *Synchronized[K, V]) Keys() []K {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.cache.Keys()
+}
+
+// GetSize returns the number of entries in the cache
+func (s *Synchronized[K, V]) GetSize() int {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.cache.GetSize()
+}
+
+// GetCost returns the total cost of the entries in the cache
+func (s *Synchronized[K, V]) GetCost() int {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.cache.GetCost()
+}
+
+// IsEmpty returns true if the cache holds no entry
+func (s *Synchronized[K, V]) IsEmpty() bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.cache.IsEmpty()
+}
+
+// Purge removes every expired entry and returns how many were removed
+func (s *Synchronized[K, V]) Purge() int {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.cache.Purge()
+}
+
+// Stats returns a snapshot of the hit/miss counters
+func (s *Synchronized[K, V]) Stats() Stats {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.cache.Stats()
+}
+
+// Clear removes every entry from the cache
+func (s *Synchronized[K, V]) Clear() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.cache.Clear()
+}
+
+// String returns the string representation of the wrapped cache
+func (s *Synchronized[K, V]) String() string {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.cache.String()
+}
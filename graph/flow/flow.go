@@ -0,0 +1,166 @@
+// Package flow computes maximum flows and minimum cuts of capacitated graphs.
+//
+// The weight of every edge of the graph is its capacity. A directed edge
+// carries flow in its own direction only, an undirected edge carries flow in
+// either direction up to its capacity.
+//
+// Reference: https://en.wikipedia.org/wiki/Maximum_flow_problem
+package flow
+
+import (
+	"errors"
+
+	"github.com/TranThang-2804/golangds/graph"
+	"github.com/TranThang-2804/golangds/queue/linkedlistqueue"
+)
+
+var (
+	// ErrVertexNotFound is returned when the source or the sink is not in the graph
+	ErrVertexNotFound = errors.New("flow: vertex not found")
+	// ErrSameVertex is returned when the source is also the sink
+	ErrSameVertex = errors.New("flow: source and sink are the same vertex")
+	// ErrNegativeCapacity is returned when an edge has a negative capacity
+	ErrNegativeCapacity = errors.New("flow: negative capacity")
+)
+
+// EdgeFlow is the flow carried by an edge of the graph
+type EdgeFlow[V comparable, W graph.Weight] struct {
+	From     V
+	To       V
+	Capacity W
+	// Flow goes from From to To, for an undirected edge a negative
+	// flow goes from To to From
+	Flow W
+}
+
+// Result is a maximum flow together with the minimum cut it saturates
+type Result[V comparable, W graph.Weight] struct {
+	// MaxFlow is the value of the flow leaving the source
+	MaxFlow W
+	// Edges lists the flow on every edge of the graph, in graph order
+	Edges []EdgeFlow[V, W]
+	// SourceSide lists the vertices still reachable from the source in the
+	// residual graph, the source side of a minimum cut
+	SourceSide []V
+	// CutEdges lists the edges going from the source side to the sink side,
+	// their capacities add up to MaxFlow
+	CutEdges []graph.Edge[V, W]
+}
+
+// arc is an edge of the residual network, arcs[i^1] is the reverse of arcs[i]
+type arc[W graph.Weight] struct {
+	to       int
+	capacity W
+	flow     W
+}
+
+// network is the residual network of a graph, vertices are identified by
+// their position in the graph and adjacency lists arc positions
+type network[V comparable, W graph.Weight] struct {
+	vertices  []V
+	index     map[V]int
+	arcs      []arc[W]
+	adjacency [][]int
+	edges     []graph.Edge[V, W]
+	source    int
+	sink      int
+}
+
+func newNetwork[V comparable, W graph.Weight](g *graph.Graph[V, W], source, sink V) (*network[V, W], error) {
+	if !g.HasVertex(source) || !g.HasVertex(sink) {
+		return nil, ErrVertexNotFound
+	}
+	if source == sink {
+		return nil, ErrSameVertex
+	}
+
+	n := &network[V, W]{
+		vertices:  g.Vertices(),
+		index:     make(map[V]int, g.VertexCount()),
+		adjacency: make([][]int, g.VertexCount()),
+		edges:     g.AllEdges(),
+	}
+	for i, vertex := range n.vertices {
+		n.index[vertex] = i
+	}
+	n.source, n.sink = n.index[source], n.index[sink]
+
+	for _, edge := range n.edges {
+		if edge.Weight < 0 {
+			return nil, ErrNegativeCapacity
+		}
+		from, to := n.index[edge.From], n.index[edge.To]
+		// The reverse arc of an undirected edge has the full capacity
+		var reverse W
+		if !g.IsDirected() {
+			reverse = edge.Weight
+		}
+		n.adjacency[from] = append(n.adjacency[from], len(n.arcs))
+		n.arcs = append(n.arcs, arc[W]{to: to, capacity: edge.Weight})
+		n.adjacency[to] = append(n.adjacency[to], len(n.arcs))
+		n.arcs = append(n.arcs, arc[W]{to: from, capacity: reverse})
+	}
+	return n, nil
+}
+
+// residual returns how much more flow the arc can carry
+func (n *network[V, W]) residual(a int) W {
+	return n.arcs[a].capacity - n.arcs[a].flow
+}
+
+// push sends flow along the arc and takes it back from the reverse arc
+func (n *network[V, W]) push(a int, flow W) {
+	n.arcs[a].flow += flow
+	n.arcs[a^1].flow -= flow
+}
+
+// levels returns the number of arcs from the source to every vertex in the
+// residual network, -1 for the unreachable vertices
+func (n *network[V, W]) levels() []int {
+	level := make([]int, len(n.vertices))
+	for i := range level {
+		level[i] = -1
+	}
+	level[n.source] = 0
+
+	queue := linkedlistqueue.New[int]()
+	queue.Enqueue(n.source)
+	for !queue.IsEmpty() {
+		vertex, _ := queue.Dequeue()
+		for _, a := range n.adjacency[vertex] {
+			if to := n.arcs[a].to; level[to] < 0 && n.residual(a) > 0 {
+				level[to] = level[vertex] + 1
+				queue.Enqueue(to)
+			}
+		}
+	}
+	return level
+}
+
+// result reads the flow and the minimum cut out of the saturated network
+func (n *network[V, W]) result() *Result[V, W] {
+	r := &Result[V, W]{Edges: make([]EdgeFlow[V, W], len(n.edges))}
+	for i, edge := range n.edges {
+		r.Edges[i] = EdgeFlow[V, W]{From: edge.From, To: edge.To, Capacity: edge.Weight, Flow: n.arcs[2*i].flow}
+	}
+	for _, a := range n.adjacency[n.source] {
+		r.MaxFlow += n.arcs[a].flow
+	}
+
+	level := n.levels()
+	for i, vertex := range n.vertices {
+		if level[i] >= 0 {
+			r.SourceSide = append(r.SourceSide, vertex)
+		}
+	}
+	for i, edge := range n.edges {
+		from, to := n.index[edge.From], n.index[edge.To]
+		if level[from] >= 0 && level[to] < 0 {
+			r.CutEdges = append(r.CutEdges, edge)
+		} else if level[to] >= 0 && level[from] < 0 && n.arcs[2*i+1].capacity > 0 {
+			// An undirected edge crossing the cut from the sink side
+			r.CutEdges = append(r.CutEdges, graph.Edge[V, W]{From: edge.To, To: edge.From, Weight: edge.Weight})
+		}
+	}
+	return r
+}
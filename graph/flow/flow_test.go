@@ -0,0 +1,189 @@
+package flow
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+
+	"github.com/TranThang-2804/golangds/graph"
+)
+
+type algorithm func(*graph.Graph[string, int], string, string) (*Result[string, int], error)
+
+var algorithms = map[string]algorithm{
+	"EdmondsKarp": EdmondsKarp[string, int],
+	"Dinic":       Dinic[string, int],
+}
+
+// newTestNetwork builds the classic example with a maximum flow of 23
+func newTestNetwork() *graph.Graph[string, int] {
+	g := graph.NewDirected[string, int]()
+	g.AddWeightedEdge("s", "v1", 16)
+	g.AddWeightedEdge("s", "v2", 13)
+	g.AddWeightedEdge("v2", "v1", 4)
+	g.AddWeightedEdge("v1", "v3", 12)
+	g.AddWeightedEdge("v3", "v2", 9)
+	g.AddWeightedEdge("v2", "v4", 14)
+	g.AddWeightedEdge("v4", "v3", 7)
+	g.AddWeightedEdge("v3", "t", 20)
+	g.AddWeightedEdge("v4", "t", 4)
+	return g
+}
+
+// assertFlow checks capacity constraints, conservation and that the cut
+// capacity equals the flow value
+func assertFlow[V comparable, W graph.Weight](t *testing.T, g *graph.Graph[V, W], result *Result[V, W], source, sink V) {
+	t.Helper()
+	excess := make(map[V]W)
+	for _, edge := range result.Edges {
+		if edge.Flow > edge.Capacity || (g.IsDirected() && edge.Flow < 0) || (!g.IsDirected() && -edge.Flow > edge.Capacity) {
+			t.Errorf("Edge %v exceeds its capacity", edge)
+		}
+		excess[edge.From] -= edge.Flow
+		excess[edge.To] += edge.Flow
+	}
+	for _, vertex := range g.Vertices() {
+		if vertex != source && vertex != sink && excess[vertex] != 0 {
+			t.Errorf("Flow is not conserved at %v", vertex)
+		}
+	}
+	if actualValue, expectedValue := excess[sink], result.MaxFlow; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	var cut W
+	for _, edge := range result.CutEdges {
+		cut += edge.Weight
+	}
+	if actualValue, expectedValue := cut, result.MaxFlow; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMaxFlow(t *testing.T) {
+	for name, maxFlow := range algorithms {
+		g := newTestNetwork()
+		result, err := maxFlow(g, "s", "t")
+		if err != nil {
+			t.Fatalf("%s: Got %v expected %v", name, err, nil)
+		}
+		if actualValue := result.MaxFlow; actualValue != 23 {
+			t.Errorf("%s: Got %v expected %v", name, actualValue, 23)
+		}
+		assertFlow(t, g, result, "s", "t")
+		if actualValue := len(result.Edges); actualValue != 9 {
+			t.Errorf("%s: Got %v expected %v", name, actualValue, 9)
+		}
+	}
+}
+
+func TestMinCut(t *testing.T) {
+	for name, maxFlow := range algorithms {
+		result, _ := maxFlow(newTestNetwork(), "s", "t")
+		if actualValue, expectedValue := len(result.SourceSide), 4; actualValue != expectedValue {
+			t.Errorf("%s: Got %v expected %v", name, actualValue, expectedValue)
+		}
+		expected := map[graph.Edge[string, int]]bool{
+			{From: "v1", To: "v3", Weight: 12}: true,
+			{From: "v4", To: "v3", Weight: 7}:  true,
+			{From: "v4", To: "t", Weight: 4}:   true,
+		}
+		if actualValue, expectedValue := len(result.CutEdges), len(expected); actualValue != expectedValue {
+			t.Fatalf("%s: Got %v expected %v", name, actualValue, expectedValue)
+		}
+		for _, edge := range result.CutEdges {
+			if !expected[edge] {
+				t.Errorf("%s: Got %v which is not in the cut", name, edge)
+			}
+		}
+	}
+}
+
+func TestUndirected(t *testing.T) {
+	g := graph.NewUndirected[string, int]()
+	g.AddWeightedEdge("s", "a", 3)
+	g.AddWeightedEdge("b", "s", 2)
+	g.AddWeightedEdge("a", "b", 5)
+	g.AddWeightedEdge("t", "a", 1)
+	g.AddWeightedEdge("b", "t", 4)
+	for name, maxFlow := range algorithms {
+		result, _ := maxFlow(g, "s", "t")
+		if actualValue := result.MaxFlow; actualValue != 5 {
+			t.Errorf("%s: Got %v expected %v", name, actualValue, 5)
+		}
+		assertFlow(t, g, result, "s", "t")
+	}
+}
+
+func TestFloatCapacities(t *testing.T) {
+	g := graph.NewDirected[int, float64]()
+	g.AddWeightedEdge(0, 1, 1.5)
+	g.AddWeightedEdge(0, 2, 0.25)
+	g.AddWeightedEdge(1, 2, 0.5)
+	g.AddWeightedEdge(1, 3, 0.75)
+	g.AddWeightedEdge(2, 3, 2)
+	for _, result := range []*Result[int, float64]{must(EdmondsKarp(g, 0, 3)), must(Dinic(g, 0, 3))} {
+		if actualValue := result.MaxFlow; actualValue != 1.5 {
+			t.Errorf("Got %v expected %v", actualValue, 1.5)
+		}
+		assertFlow(t, g, result, 0, 3)
+	}
+}
+
+func TestUnreachableSink(t *testing.T) {
+	g := graph.NewDirected[string, int]()
+	g.AddWeightedEdge("s", "a", 3)
+	g.AddWeightedEdge("t", "a", 3)
+	for name, maxFlow := range algorithms {
+		result, _ := maxFlow(g, "s", "t")
+		if actualValue := result.MaxFlow; actualValue != 0 {
+			t.Errorf("%s: Got %v expected %v", name, actualValue, 0)
+		}
+		if actualValue := len(result.CutEdges); actualValue != 0 {
+			t.Errorf("%s: Got %v expected %v", name, actualValue, 0)
+		}
+	}
+}
+
+func TestEdmondsKarpMatchesDinic(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	for round := 0; round < 20; round++ {
+		g := graph.NewDirected[int, int]()
+		g.AddVertex(0, 1)
+		for i := 0; i < 200; i++ {
+			g.AddWeightedEdge(random.Intn(40), random.Intn(40), random.Intn(20))
+		}
+		edmondsKarp, _ := EdmondsKarp(g, 0, 1)
+		dinic, _ := Dinic(g, 0, 1)
+		assertFlow(t, g, edmondsKarp, 0, 1)
+		assertFlow(t, g, dinic, 0, 1)
+		if edmondsKarp.MaxFlow != dinic.MaxFlow {
+			t.Fatalf("Got %v expected %v", dinic.MaxFlow, edmondsKarp.MaxFlow)
+		}
+	}
+}
+
+func TestErrors(t *testing.T) {
+	g := newTestNetwork()
+	for name, maxFlow := range algorithms {
+		if _, err := maxFlow(g, "s", "x"); !errors.Is(err, ErrVertexNotFound) {
+			t.Errorf("%s: Got %v expected %v", name, err, ErrVertexNotFound)
+		}
+		if _, err := maxFlow(g, "s", "s"); !errors.Is(err, ErrSameVertex) {
+			t.Errorf("%s: Got %v expected %v", name, err, ErrSameVertex)
+		}
+	}
+	g.AddWeightedEdge("v1", "t", -1)
+	for name, maxFlow := range algorithms {
+		if _, err := maxFlow(g, "s", "t"); !errors.Is(err, ErrNegativeCapacity) {
+			t.Errorf("%s: Got %v expected %v", name, err, ErrNegativeCapacity)
+		}
+	}
+}
+
+func must[V comparable, W graph.Weight](result *Result[V, W], err error) *Result[V, W] {
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
@@ -0,0 +1,118 @@
+package flow
+
+import (
+	"github.com/TranThang-2804/golangds/graph"
+	"github.com/TranThang-2804/golangds/queue/linkedlistqueue"
+)
+
+// EdmondsKarp computes a maximum flow from the source to the sink by
+// repeatedly augmenting along a shortest residual path found with a breadth
+// first search, in O(V*E^2)
+func EdmondsKarp[V comparable, W graph.Weight](g *graph.Graph[V, W], source, sink V) (*Result[V, W], error) {
+	n, err := newNetwork(g, source, sink)
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		// parentArc[v] is the arc the search reached v through
+		parentArc := make([]int, len(n.vertices))
+		for i := range parentArc {
+			parentArc[i] = -1
+		}
+
+		queue := linkedlistqueue.New[int]()
+		queue.Enqueue(n.source)
+		for !queue.IsEmpty() && parentArc[n.sink] < 0 {
+			vertex, _ := queue.Dequeue()
+			for _, a := range n.adjacency[vertex] {
+				to := n.arcs[a].to
+				if to != n.source && parentArc[to] < 0 && n.residual(a) > 0 {
+					parentArc[to] = a
+					queue.Enqueue(to)
+				}
+			}
+		}
+		if parentArc[n.sink] < 0 {
+			break
+		}
+
+		bottleneck := n.residual(parentArc[n.sink])
+		for vertex := n.sink; vertex != n.source; vertex = n.arcs[parentArc[vertex]^1].to {
+			bottleneck = min(bottleneck, n.residual(parentArc[vertex]))
+		}
+		for vertex := n.sink; vertex != n.source; vertex = n.arcs[parentArc[vertex]^1].to {
+			n.push(parentArc[vertex], bottleneck)
+		}
+	}
+	return n.result(), nil
+}
+
+// Dinic computes a maximum flow from the source to the sink by saturating
+// the level graph of the residual network with a blocking flow, phase after
+// phase, in O(V^2*E)
+func Dinic[V comparable, W graph.Weight](g *graph.Graph[V, W], source, sink V) (*Result[V, W], error) {
+	n, err := newNetwork(g, source, sink)
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		level := n.levels()
+		if level[n.sink] < 0 {
+			break
+		}
+
+		// next[v] is the position of the first arc of v that may still
+		// lead to the sink in this phase
+		next := make([]int, len(n.vertices))
+		for {
+			path := n.levelPath(level, next)
+			if path == nil {
+				break
+			}
+			bottleneck := n.residual(path[0])
+			for _, a := range path[1:] {
+				bottleneck = min(bottleneck, n.residual(a))
+			}
+			for _, a := range path {
+				n.push(a, bottleneck)
+			}
+		}
+	}
+	return n.result(), nil
+}
+
+// levelPath returns the arcs of a residual path from the source to the sink
+// going one level down at every step, or nil if the level graph is blocked.
+// Arcs proven useless are skipped for the rest of the phase.
+func (n *network[V, W]) levelPath(level []int, next []int) []int {
+	path := []int{}
+	vertex := n.source
+	for vertex != n.sink {
+		advanced := false
+		for ; next[vertex] < len(n.adjacency[vertex]); next[vertex]++ {
+			a := n.adjacency[vertex][next[vertex]]
+			if to := n.arcs[a].to; level[to] == level[vertex]+1 && n.residual(a) > 0 {
+				path = append(path, a)
+				vertex = to
+				advanced = true
+				break
+			}
+		}
+		if advanced {
+			continue
+		}
+
+		// Dead end, retreat and never come back to this vertex in this phase
+		if vertex == n.source {
+			return nil
+		}
+		level[vertex] = -1
+		last := path[len(path)-1]
+		path = path[:len(path)-1]
+		vertex = n.arcs[last^1].to
+		next[vertex]++
+	}
+	return path
+}
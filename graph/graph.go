@@ -0,0 +1,294 @@
+// Package graph provides a generic graph stored as adjacency lists.
+//
+// In computer science, a graph is an abstract data type that is meant to implement the undirected graph and directed graph concepts from the field of graph theory within mathematics. A graph data structure consists of a finite set of vertices, together with a set of unordered pairs of these vertices for an undirected graph or a set of ordered pairs for a directed graph. These pairs are known as edges, and may carry a weight.
+//
+// Vertices and the outgoing edges of every vertex keep their insertion order,
+// so every algorithm built on this package visits them deterministically.
+//
+// Reference: https://en.wikipedia.org/wiki/Graph_(abstract_data_type)
+package graph
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// Weight is the set of types that can be used as edge weights
+type Weight interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// Edge is a connection from one vertex to another
+type Edge[V comparable, W Weight] struct {
+	From   V
+	To     V
+	Weight W
+}
+
+// Graph struct, adjacency holds the outgoing edges of
+// the vertex stored at the same position in vertices
+type Graph[V comparable, W Weight] struct {
+	directed  bool
+	vertices  []V
+	index     map[V]int
+	adjacency [][]Edge[V, W]
+	edgeCount int
+}
+
+// Create a new empty directed graph
+func NewDirected[V comparable, W Weight]() *Graph[V, W] {
+	return &Graph[V, W]{directed: true, index: make(map[V]int)}
+}
+
+// Create a new empty undirected graph
+func NewUndirected[V comparable, W Weight]() *Graph[V, W] {
+	return &Graph[V, W]{directed: false, index: make(map[V]int)}
+}
+
+// Check if the edges of the graph are directed
+func (g *Graph[V, W]) IsDirected() bool {
+	return g.directed
+}
+
+// Add the vertices to the graph, existing vertices are ignored
+func (g *Graph[V, W]) AddVertex(vertices ...V) {
+	for _, vertex := range vertices {
+		if _, ok := g.index[vertex]; ok {
+			continue
+		}
+		g.index[vertex] = len(g.vertices)
+		g.vertices = append(g.vertices, vertex)
+		g.adjacency = append(g.adjacency, nil)
+	}
+}
+
+// Add an unweighted edge, that is an edge of weight 1.
+// Missing vertices are added to the graph.
+func (g *Graph[V, W]) AddEdge(from, to V) {
+	g.AddWeightedEdge(from, to, 1)
+}
+
+// Add an edge with the given weight, for an undirected graph the edge
+// can be walked both ways. Missing vertices are added to the graph.
+func (g *Graph[V, W]) AddWeightedEdge(from, to V, weight W) {
+	g.AddVertex(from, to)
+
+	i := g.index[from]
+	g.adjacency[i] = append(g.adjacency[i], Edge[V, W]{From: from, To: to, Weight: weight})
+	if !g.directed && from != to {
+		j := g.index[to]
+		g.adjacency[j] = append(g.adjacency[j], Edge[V, W]{From: to, To: from, Weight: weight})
+	}
+	g.edgeCount++
+}
+
+// Remove every edge going from one vertex to the other
+// return true if an edge is removed else return false
+func (g *Graph[V, W]) RemoveEdge(from, to V) bool {
+	i, ok := g.index[from]
+	if !ok {
+		return false
+	}
+	if _, ok := g.index[to]; !ok {
+		return false
+	}
+
+	removed := g.removeEdgesTo(i, to)
+	if !g.directed && from != to {
+		g.removeEdgesTo(g.index[to], from)
+	}
+	g.edgeCount -= removed
+	return removed > 0
+}
+
+// Remove the vertex and every edge touching it
+// return true if the vertex is removed else return false
+func (g *Graph[V, W]) RemoveVertex(vertex V) bool {
+	i, ok := g.index[vertex]
+	if !ok {
+		return false
+	}
+
+	if g.directed {
+		g.edgeCount -= len(g.adjacency[i])
+		for j := range g.adjacency {
+			if j != i {
+				g.edgeCount -= g.removeEdgesTo(j, vertex)
+			}
+		}
+	} else {
+		for _, edge := range g.adjacency[i] {
+			if edge.To != vertex {
+				g.removeEdgesTo(g.index[edge.To], vertex)
+			}
+		}
+		g.edgeCount -= len(g.adjacency[i])
+	}
+
+	g.vertices = slices.Delete(g.vertices, i, i+1)
+	g.adjacency = slices.Delete(g.adjacency, i, i+1)
+	delete(g.index, vertex)
+	for j := i; j < len(g.vertices); j++ {
+		g.index[g.vertices[j]] = j
+	}
+	return true
+}
+
+// Check if the graph contains the vertex
+func (g *Graph[V, W]) HasVertex(vertex V) bool {
+	_, ok := g.index[vertex]
+	return ok
+}
+
+// Check if there is an edge going from one vertex to the other
+func (g *Graph[V, W]) HasEdge(from, to V) bool {
+	_, ok := g.Weight(from, to)
+	return ok
+}
+
+// Get the weight of the first edge going from one vertex to the other
+// return true if the edge is found else return false
+func (g *Graph[V, W]) Weight(from, to V) (W, bool) {
+	if i, ok := g.index[from]; ok {
+		for _, edge := range g.adjacency[i] {
+			if edge.To == to {
+				return edge.Weight, true
+			}
+		}
+	}
+	var zeroValue W
+	return zeroValue, false
+}
+
+// Return the vertices reachable from the vertex through a single edge
+func (g *Graph[V, W]) Neighbors(vertex V) []V {
+	i, ok := g.index[vertex]
+	if !ok {
+		return nil
+	}
+	neighbors := make([]V, 0, len(g.adjacency[i]))
+	for _, edge := range g.adjacency[i] {
+		neighbors = append(neighbors, edge.To)
+	}
+	return neighbors
+}
+
+// Return the edges leaving the vertex, for an undirected graph
+// every edge touching the vertex is returned with From set to the vertex
+func (g *Graph[V, W]) Edges(vertex V) []Edge[V, W] {
+	i, ok := g.index[vertex]
+	if !ok {
+		return nil
+	}
+	return slices.Clone(g.adjacency[i])
+}
+
+// Return every edge of the graph, an undirected edge is returned once
+func (g *Graph[V, W]) AllEdges() []Edge[V, W] {
+	edges := make([]Edge[V, W], 0, g.edgeCount)
+	for i, vertexEdges := range g.adjacency {
+		for _, edge := range vertexEdges {
+			// An undirected edge is stored on both ends,
+			// keep the copy stored on the endpoint added first
+			if g.directed || i <= g.index[edge.To] {
+				edges = append(edges, edge)
+			}
+		}
+	}
+	return edges
+}
+
+// Return the number of edges leaving the vertex
+func (g *Graph[V, W]) Degree(vertex V) int {
+	i, ok := g.index[vertex]
+	if !ok {
+		return 0
+	}
+	return len(g.adjacency[i])
+}
+
+// Return all the vertices in insertion order
+func (g *Graph[V, W]) Vertices() []V {
+	return slices.Clone(g.vertices)
+}
+
+// Get the number of vertices in the graph
+func (g *Graph[V, W]) VertexCount() int {
+	return len(g.vertices)
+}
+
+// Get the number of edges in the graph, an undirected edge counts once
+func (g *Graph[V, W]) EdgeCount() int {
+	return g.edgeCount
+}
+
+// Check if the graph has no vertex
+func (g *Graph[V, W]) IsEmpty() bool {
+	return len(g.vertices) == 0
+}
+
+// Return a new graph with every edge reversed, vertices keep their order.
+// The transpose of an undirected graph is a copy of the graph.
+func (g *Graph[V, W]) Transpose() *Graph[V, W] {
+	transpose := &Graph[V, W]{directed: g.directed, index: make(map[V]int, len(g.vertices))}
+	transpose.AddVertex(g.vertices...)
+	for _, edge := range g.AllEdges() {
+		transpose.AddWeightedEdge(edge.To, edge.From, edge.Weight)
+	}
+	return transpose
+}
+
+// Return a new graph with the same vertices and edges, in the same order
+func (g *Graph[V, W]) Clone() *Graph[V, W] {
+	adjacency := make([][]Edge[V, W], len(g.adjacency))
+	for i, edges := range g.adjacency {
+		adjacency[i] = slices.Clone(edges)
+	}
+	return &Graph[V, W]{
+		directed:  g.directed,
+		vertices:  slices.Clone(g.vertices),
+		index:     maps.Clone(g.index),
+		adjacency: adjacency,
+		edgeCount: g.edgeCount,
+	}
+}
+
+// Clear all the vertices and edges of the graph
+func (g *Graph[V, W]) Clear() {
+	g.vertices = nil
+	g.index = make(map[V]int)
+	g.adjacency = nil
+	g.edgeCount = 0
+}
+
+// Return the string representation of the graph, one vertex per line
+func (g *Graph[V, W]) String() string {
+	str := "UndirectedGraph\n"
+	if g.directed {
+		str = "DirectedGraph\n"
+	}
+	lines := []string{}
+	for i, vertex := range g.vertices {
+		neighbors := []string{}
+		for _, edge := range g.adjacency[i] {
+			neighbors = append(neighbors, fmt.Sprintf("%v(%v)", edge.To, edge.Weight))
+		}
+		lines = append(lines, fmt.Sprintf("%v -> %s", vertex, strings.Join(neighbors, ", ")))
+	}
+	str += strings.Join(lines, "\n")
+	return str
+}
+
+// removeEdgesTo removes the edges stored at position i that lead to the
+// vertex and returns how many were removed
+func (g *Graph[V, W]) removeEdgesTo(i int, to V) int {
+	before := len(g.adjacency[i])
+	g.adjacency[i] = slices.DeleteFunc(g.adjacency[i], func(edge Edge[V, W]) bool {
+		return edge.To == to
+	})
+	return before - len(g.adjacency[i])
+}
@@ -0,0 +1,204 @@
+package graph
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestGraphAddVertex(t *testing.T) {
+	g := NewDirected[string, int]()
+	if actualValue := g.IsEmpty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	g.AddVertex("a", "b")
+	g.AddVertex("b", "c")
+	if actualValue, expectedValue := g.Vertices(), []string{"a", "b", "c"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := g.HasVertex("c"); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := g.HasVertex("d"); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+}
+
+func TestGraphDirectedEdges(t *testing.T) {
+	g := NewDirected[string, int]()
+	g.AddWeightedEdge("a", "b", 5)
+	g.AddEdge("a", "c")
+	g.AddEdge("c", "a")
+	if actualValue := g.VertexCount(); actualValue != 3 {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	if actualValue := g.EdgeCount(); actualValue != 3 {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	if actualValue, ok := g.Weight("a", "b"); actualValue != 5 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 5)
+	}
+	if actualValue, ok := g.Weight("a", "c"); actualValue != 1 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	if actualValue := g.HasEdge("b", "a"); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue, expectedValue := g.Neighbors("a"), []string{"b", "c"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := g.Degree("a"); actualValue != 2 {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+	if actualValue := len(g.AllEdges()); actualValue != 3 {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+}
+
+func TestGraphUndirectedEdges(t *testing.T) {
+	g := NewUndirected[string, float64]()
+	g.AddWeightedEdge("a", "b", 1.5)
+	g.AddWeightedEdge("b", "c", 2.5)
+	g.AddWeightedEdge("c", "c", 1)
+	if actualValue := g.EdgeCount(); actualValue != 3 {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	if actualValue, ok := g.Weight("b", "a"); actualValue != 1.5 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 1.5)
+	}
+	if actualValue, expectedValue := g.Neighbors("b"), []string{"a", "c"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	expectedEdges := []Edge[string, float64]{{"a", "b", 1.5}, {"b", "c", 2.5}, {"c", "c", 1}}
+	if actualValue := g.AllEdges(); !slices.Equal(actualValue, expectedEdges) {
+		t.Errorf("Got %v expected %v", actualValue, expectedEdges)
+	}
+}
+
+func TestGraphRemoveEdge(t *testing.T) {
+	g := NewUndirected[string, int]()
+	g.AddEdge("a", "b")
+	g.AddEdge("a", "b")
+	g.AddEdge("b", "c")
+	if actualValue := g.RemoveEdge("b", "a"); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := g.RemoveEdge("b", "a"); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := g.RemoveEdge("x", "a"); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := g.HasEdge("a", "b"); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := g.EdgeCount(); actualValue != 1 {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+}
+
+func TestGraphRemoveVertex(t *testing.T) {
+	directed := NewDirected[string, int]()
+	directed.AddEdge("a", "b")
+	directed.AddEdge("b", "c")
+	directed.AddEdge("c", "b")
+	directed.AddEdge("b", "b")
+	directed.AddEdge("a", "c")
+	if actualValue := directed.RemoveVertex("b"); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := directed.RemoveVertex("b"); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := directed.EdgeCount(); actualValue != 1 {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	if actualValue, expectedValue := directed.Vertices(), []string{"a", "c"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := directed.HasEdge("a", "c"); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+
+	undirected := NewUndirected[string, int]()
+	undirected.AddEdge("a", "b")
+	undirected.AddEdge("b", "c")
+	undirected.AddEdge("b", "b")
+	undirected.AddEdge("a", "c")
+	undirected.RemoveVertex("b")
+	if actualValue := undirected.EdgeCount(); actualValue != 1 {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	if actualValue, expectedValue := undirected.Neighbors("c"), []string{"a"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestGraphTranspose(t *testing.T) {
+	g := NewDirected[string, int]()
+	g.AddWeightedEdge("a", "b", 2)
+	g.AddWeightedEdge("b", "c", 3)
+	g.AddVertex("d")
+	transpose := g.Transpose()
+	if actualValue, expectedValue := transpose.Vertices(), []string{"a", "b", "c", "d"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, ok := transpose.Weight("c", "b"); actualValue != 3 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	if actualValue := transpose.HasEdge("a", "b"); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := g.HasEdge("a", "b"); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
+func TestGraphClear(t *testing.T) {
+	g := NewDirected[int, int]()
+	g.AddEdge(1, 2)
+	g.Clear()
+	if actualValue := g.IsEmpty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := g.EdgeCount(); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+	g.AddEdge(3, 4)
+	if actualValue, expectedValue := g.Vertices(), []int{3, 4}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestGraphString(t *testing.T) {
+	g := NewDirected[int, int]()
+	g.AddEdge(1, 2)
+	if !strings.HasPrefix(g.String(), "DirectedGraph") {
+		t.Errorf("String should start with container name")
+	}
+	if !strings.HasPrefix(NewUndirected[int, int]().String(), "UndirectedGraph") {
+		t.Errorf("String should start with container name")
+	}
+}
+
+func TestGraphClone(t *testing.T) {
+	g := NewDirected[string, int]()
+	g.AddWeightedEdge("a", "b", 1)
+	g.AddWeightedEdge("b", "c", 2)
+	clone := g.Clone()
+	clone.AddWeightedEdge("a", "c", 3)
+	g.RemoveEdge("a", "b")
+
+	if actualValue, expectedValue := clone.String(), "DirectedGraph\na -> b(1), c(3)\nb -> c(2)\nc -> "; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := g.String(), "DirectedGraph\na -> \nb -> c(2)\nc -> "; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := clone.EdgeCount(), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := clone.IsDirected(), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
@@ -0,0 +1,201 @@
+// Package matching computes maximum matchings of bipartite graphs.
+//
+// A matching is a set of edges without common vertices. In a bipartite graph
+// the vertices split into two sides such that every edge joins both sides.
+// The direction and the weight of the edges are ignored.
+//
+// Reference: https://en.wikipedia.org/wiki/Hopcroft%E2%80%93Karp_algorithm
+package matching
+
+import (
+	"errors"
+
+	"github.com/TranThang-2804/golangds/graph"
+	"github.com/TranThang-2804/golangds/queue/linkedlistqueue"
+)
+
+// ErrNotBipartite is returned when the graph has an odd cycle
+var ErrNotBipartite = errors.New("matching: graph is not bipartite")
+
+// Pair is an edge of the matching
+type Pair[V comparable] struct {
+	Left  V
+	Right V
+}
+
+// Matching is a maximum matching of a bipartite graph
+type Matching[V comparable] struct {
+	// Pairs lists the matched edges, ordered by left vertex in graph order
+	Pairs []Pair[V]
+	// Mate maps every matched vertex, on either side, to its partner
+	Mate map[V]V
+}
+
+// Get the number of edges in the matching
+func (m *Matching[V]) Size() int {
+	return len(m.Pairs)
+}
+
+// Bipartition splits the vertices into two sides so that every edge joins
+// both sides. The first vertex of every connected component goes left.
+// return true if the graph is bipartite else return false
+func Bipartition[V comparable, W graph.Weight](g *graph.Graph[V, W]) ([]V, []V, bool) {
+	adjacency := undirectedAdjacency(g)
+	side := make(map[V]bool, g.VertexCount())
+	left, right := []V{}, []V{}
+
+	for _, root := range g.Vertices() {
+		if _, ok := side[root]; ok {
+			continue
+		}
+		side[root] = false
+
+		queue := linkedlistqueue.New[V]()
+		queue.Enqueue(root)
+		for !queue.IsEmpty() {
+			vertex, _ := queue.Dequeue()
+			for _, next := range adjacency[vertex] {
+				nextSide, ok := side[next]
+				if !ok {
+					side[next] = !side[vertex]
+					queue.Enqueue(next)
+				} else if nextSide == side[vertex] {
+					return nil, nil, false
+				}
+			}
+		}
+	}
+
+	for _, vertex := range g.Vertices() {
+		if side[vertex] {
+			right = append(right, vertex)
+		} else {
+			left = append(left, vertex)
+		}
+	}
+	return left, right, true
+}
+
+// HopcroftKarp computes a maximum matching in O(E*sqrt(V)) by augmenting
+// along a maximal set of shortest vertex-disjoint augmenting paths per phase.
+// The sides are found with Bipartition.
+func HopcroftKarp[V comparable, W graph.Weight](g *graph.Graph[V, W]) (*Matching[V], error) {
+	left, _, ok := Bipartition(g)
+	if !ok {
+		return nil, ErrNotBipartite
+	}
+	adjacency := undirectedAdjacency(g)
+
+	// Vertices are identified by their position on their side
+	rightIndex := make(map[V]int)
+	right := []V{}
+	neighbors := make([][]int, len(left))
+	for i, vertex := range left {
+		for _, next := range adjacency[vertex] {
+			j, ok := rightIndex[next]
+			if !ok {
+				j = len(right)
+				rightIndex[next] = j
+				right = append(right, next)
+			}
+			neighbors[i] = append(neighbors[i], j)
+		}
+	}
+
+	h := &hopcroftKarp{
+		neighbors: neighbors,
+		mateLeft:  make([]int, len(left)),
+		mateRight: make([]int, len(right)),
+		distance:  make([]int, len(left)),
+	}
+	for i := range h.mateLeft {
+		h.mateLeft[i] = -1
+	}
+	for j := range h.mateRight {
+		h.mateRight[j] = -1
+	}
+	for h.layer() {
+		for i := range left {
+			if h.mateLeft[i] < 0 {
+				h.augment(i)
+			}
+		}
+	}
+
+	m := &Matching[V]{Mate: make(map[V]V)}
+	for i, j := range h.mateLeft {
+		if j >= 0 {
+			m.Pairs = append(m.Pairs, Pair[V]{Left: left[i], Right: right[j]})
+			m.Mate[left[i]] = right[j]
+			m.Mate[right[j]] = left[i]
+		}
+	}
+	return m, nil
+}
+
+// hopcroftKarp holds the state of the algorithm over vertex positions,
+// a mate of -1 means unmatched
+type hopcroftKarp struct {
+	neighbors [][]int
+	mateLeft  []int
+	mateRight []int
+	// distance is the layer of every left vertex in the current phase
+	distance []int
+}
+
+// layer runs a breadth first search from the free left vertices alternating
+// unmatched and matched edges, and reports whether a free right vertex is reachable
+func (h *hopcroftKarp) layer() bool {
+	queue := linkedlistqueue.New[int]()
+	for i, mate := range h.mateLeft {
+		if mate < 0 {
+			h.distance[i] = 0
+			queue.Enqueue(i)
+		} else {
+			h.distance[i] = -1
+		}
+	}
+
+	found := false
+	for !queue.IsEmpty() {
+		i, _ := queue.Dequeue()
+		for _, j := range h.neighbors[i] {
+			mate := h.mateRight[j]
+			if mate < 0 {
+				found = true
+			} else if h.distance[mate] < 0 {
+				h.distance[mate] = h.distance[i] + 1
+				queue.Enqueue(mate)
+			}
+		}
+	}
+	return found
+}
+
+// augment looks for an augmenting path from the left vertex along the layers
+// and flips it, reporting whether it found one
+func (h *hopcroftKarp) augment(i int) bool {
+	for _, j := range h.neighbors[i] {
+		mate := h.mateRight[j]
+		if mate < 0 || (h.distance[mate] == h.distance[i]+1 && h.augment(mate)) {
+			h.mateLeft[i] = j
+			h.mateRight[j] = i
+			return true
+		}
+	}
+	// No path goes through this vertex anymore in this phase
+	h.distance[i] = -1
+	return false
+}
+
+// undirectedAdjacency lists the neighbors of every vertex ignoring edge directions
+func undirectedAdjacency[V comparable, W graph.Weight](g *graph.Graph[V, W]) map[V][]V {
+	adjacency := make(map[V][]V, g.VertexCount())
+	for _, edge := range g.AllEdges() {
+		adjacency[edge.From] = append(adjacency[edge.From], edge.To)
+		if edge.From != edge.To {
+			adjacency[edge.To] = append(adjacency[edge.To], edge.From)
+		}
+	}
+	return adjacency
+}
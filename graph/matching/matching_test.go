@@ -0,0 +1,146 @@
+package matching
+
+import (
+	"errors"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/TranThang-2804/golangds/graph"
+)
+
+// assertMatching checks that the pairs are edges of the graph without common
+// vertices and agree with the mate map
+func assertMatching[V comparable, W graph.Weight](t *testing.T, g *graph.Graph[V, W], m *Matching[V]) {
+	t.Helper()
+	used := make(map[V]bool)
+	for _, pair := range m.Pairs {
+		if !g.HasEdge(pair.Left, pair.Right) && !g.HasEdge(pair.Right, pair.Left) {
+			t.Errorf("Pair %v is not an edge of the graph", pair)
+		}
+		if used[pair.Left] || used[pair.Right] {
+			t.Errorf("Pair %v shares a vertex", pair)
+		}
+		used[pair.Left], used[pair.Right] = true, true
+		if m.Mate[pair.Left] != pair.Right || m.Mate[pair.Right] != pair.Left {
+			t.Errorf("Pair %v disagrees with the mates", pair)
+		}
+	}
+	if actualValue, expectedValue := len(m.Mate), 2*m.Size(); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestBipartition(t *testing.T) {
+	g := graph.NewUndirected[int, int]()
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 3)
+	g.AddEdge(3, 4)
+	g.AddEdge(5, 6)
+	g.AddVertex(7)
+	left, right, ok := Bipartition(g)
+	if actualValue := ok; actualValue != true {
+		t.Fatalf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue, expectedValue := left, []int{1, 3, 5, 7}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := right, []int{2, 4, 6}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	g.AddEdge(4, 2)
+	if _, _, ok := Bipartition(g); ok != false {
+		t.Errorf("Got %v expected %v", ok, false)
+	}
+}
+
+func TestHopcroftKarp(t *testing.T) {
+	// Greedy matching a-1, b-2 blocks c and d, the maximum matches everyone
+	g := graph.NewUndirected[string, int]()
+	g.AddEdge("a", "1")
+	g.AddEdge("a", "2")
+	g.AddEdge("b", "2")
+	g.AddEdge("b", "3")
+	g.AddEdge("c", "1")
+	g.AddEdge("d", "3")
+	g.AddEdge("d", "4")
+	m, err := HopcroftKarp(g)
+	if err != nil {
+		t.Fatalf("Got %v expected %v", err, nil)
+	}
+	assertMatching(t, g, m)
+	if actualValue := m.Size(); actualValue != 4 {
+		t.Errorf("Got %v expected %v", actualValue, 4)
+	}
+	if actualValue, expectedValue := m.Mate["c"], "1"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestHopcroftKarpDirected(t *testing.T) {
+	// Directions are ignored
+	g := graph.NewDirected[int, int]()
+	g.AddEdge(1, 10)
+	g.AddEdge(11, 1)
+	g.AddEdge(2, 10)
+	g.AddVertex(3)
+	m, _ := HopcroftKarp(g)
+	assertMatching(t, g, m)
+	if actualValue := m.Size(); actualValue != 2 {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+	if _, ok := m.Mate[3]; ok != false {
+		t.Errorf("Got %v expected %v", ok, false)
+	}
+}
+
+func TestNotBipartite(t *testing.T) {
+	g := graph.NewUndirected[int, int]()
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 3)
+	g.AddEdge(3, 1)
+	if _, err := HopcroftKarp(g); !errors.Is(err, ErrNotBipartite) {
+		t.Errorf("Got %v expected %v", err, ErrNotBipartite)
+	}
+}
+
+func TestEmpty(t *testing.T) {
+	m, err := HopcroftKarp(graph.NewUndirected[int, int]())
+	if err != nil {
+		t.Fatalf("Got %v expected %v", err, nil)
+	}
+	if actualValue := m.Size(); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+}
+
+func TestMatchesBruteForce(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	for round := 0; round < 50; round++ {
+		g := graph.NewUndirected[int, int]()
+		for i := 0; i < 15; i++ {
+			g.AddEdge(random.Intn(6), 10+random.Intn(6))
+		}
+		m, _ := HopcroftKarp(g)
+		assertMatching(t, g, m)
+		if actualValue, expectedValue := m.Size(), bruteForce(g.AllEdges(), map[int]bool{}); actualValue != expectedValue {
+			t.Fatalf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+}
+
+// bruteForce returns the size of a maximum matching by trying every subset of edges
+func bruteForce(edges []graph.Edge[int, int], used map[int]bool) int {
+	if len(edges) == 0 {
+		return 0
+	}
+	best := bruteForce(edges[1:], used)
+	edge := edges[0]
+	if !used[edge.From] && !used[edge.To] {
+		used[edge.From], used[edge.To] = true, true
+		best = max(best, 1+bruteForce(edges[1:], used))
+		used[edge.From], used[edge.To] = false, false
+	}
+	return best
+}
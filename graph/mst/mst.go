@@ -0,0 +1,108 @@
+// Package mst computes minimum spanning trees of weighted undirected graphs.
+//
+// A minimum spanning tree connects every vertex of a connected graph using
+// the edges of least total weight. On a disconnected graph both algorithms
+// return a minimum spanning forest, one tree per connected component.
+//
+// Reference: https://en.wikipedia.org/wiki/Minimum_spanning_tree
+package mst
+
+import (
+	"cmp"
+	"errors"
+	"slices"
+
+	"github.com/TranThang-2804/golangds/graph"
+	"github.com/TranThang-2804/golangds/queue/priorityqueue"
+	"github.com/TranThang-2804/golangds/set/disjointset"
+)
+
+// ErrDirected is returned when the graph is directed
+var ErrDirected = errors.New("mst: graph is directed")
+
+// Result is a minimum spanning forest
+type Result[V comparable, W graph.Weight] struct {
+	// Edges lists the edges of the forest in the order they were chosen
+	Edges []graph.Edge[V, W]
+	// TotalWeight is the sum of the weights of the edges
+	TotalWeight W
+	// Trees is the number of trees in the forest, 1 if the graph is connected
+	Trees int
+}
+
+// Check if the forest is a single tree spanning the whole graph
+func (r *Result[V, W]) IsSpanningTree() bool {
+	return r.Trees <= 1
+}
+
+// Kruskal adds the edges by increasing weight, skipping those that would
+// close a cycle, in O(E log E). Edges of equal weight keep graph order.
+func Kruskal[V comparable, W graph.Weight](g *graph.Graph[V, W]) (*Result[V, W], error) {
+	if g.IsDirected() {
+		return nil, ErrDirected
+	}
+
+	edges := g.AllEdges()
+	slices.SortStableFunc(edges, compareEdges[V, W])
+
+	components := disjointset.New[V]()
+	components.MakeSet(g.Vertices()...)
+
+	result := &Result[V, W]{}
+	for _, edge := range edges {
+		if components.Union(edge.From, edge.To) {
+			result.add(edge)
+		}
+	}
+	result.Trees = components.SetCount()
+	return result, nil
+}
+
+// Prim grows a tree from a vertex by repeatedly adding the lightest edge
+// leaving it, in O(E log E). A new tree is started from the next unreached
+// vertex in graph order until every vertex is covered.
+func Prim[V comparable, W graph.Weight](g *graph.Graph[V, W]) (*Result[V, W], error) {
+	if g.IsDirected() {
+		return nil, ErrDirected
+	}
+
+	result := &Result[V, W]{}
+	inTree := make(map[V]bool, g.VertexCount())
+	queue := priorityqueue.New[graph.Edge[V, W]](compareEdges[V, W])
+
+	for _, root := range g.Vertices() {
+		if inTree[root] {
+			continue
+		}
+
+		result.Trees++
+		inTree[root] = true
+		for _, edge := range g.Edges(root) {
+			queue.Enqueue(edge)
+		}
+		for !queue.IsEmpty() {
+			edge, _ := queue.Dequeue()
+			if inTree[edge.To] {
+				continue
+			}
+			inTree[edge.To] = true
+			result.add(edge)
+			for _, next := range g.Edges(edge.To) {
+				if !inTree[next.To] {
+					queue.Enqueue(next)
+				}
+			}
+		}
+	}
+	return result, nil
+}
+
+func (r *Result[V, W]) add(edge graph.Edge[V, W]) {
+	r.Edges = append(r.Edges, edge)
+	r.TotalWeight += edge.Weight
+}
+
+// compareEdges orders edges by increasing weight
+func compareEdges[V comparable, W graph.Weight](a, b graph.Edge[V, W]) int {
+	return cmp.Compare(a.Weight, b.Weight)
+}
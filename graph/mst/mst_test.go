@@ -0,0 +1,148 @@
+package mst
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+
+	"github.com/TranThang-2804/golangds/graph"
+	"github.com/TranThang-2804/golangds/set/disjointset"
+)
+
+// newTestGraph builds the classic example with a minimum spanning tree of weight 37
+func newTestGraph() *graph.Graph[int, int] {
+	g := graph.NewUndirected[int, int]()
+	g.AddWeightedEdge(0, 1, 4)
+	g.AddWeightedEdge(0, 7, 8)
+	g.AddWeightedEdge(1, 2, 8)
+	g.AddWeightedEdge(1, 7, 11)
+	g.AddWeightedEdge(2, 3, 7)
+	g.AddWeightedEdge(2, 8, 2)
+	g.AddWeightedEdge(2, 5, 4)
+	g.AddWeightedEdge(3, 4, 9)
+	g.AddWeightedEdge(3, 5, 14)
+	g.AddWeightedEdge(4, 5, 10)
+	g.AddWeightedEdge(5, 6, 2)
+	g.AddWeightedEdge(6, 7, 1)
+	g.AddWeightedEdge(6, 8, 6)
+	g.AddWeightedEdge(7, 8, 7)
+	return g
+}
+
+// assertForest checks that the edges belong to the graph, form no cycle
+// and add up to the total weight
+func assertForest(t *testing.T, g *graph.Graph[int, int], result *Result[int, int]) {
+	t.Helper()
+	components := disjointset.New[int]()
+	components.MakeSet(g.Vertices()...)
+	total := 0
+	for _, edge := range result.Edges {
+		if !g.HasEdge(edge.From, edge.To) {
+			t.Errorf("Edge %v is not in the graph", edge)
+		}
+		if !components.Union(edge.From, edge.To) {
+			t.Errorf("Edge %v closes a cycle", edge)
+		}
+		total += edge.Weight
+	}
+	if actualValue, expectedValue := result.TotalWeight, total; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := result.Trees, components.SetCount(); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestKruskal(t *testing.T) {
+	g := newTestGraph()
+	result, err := Kruskal(g)
+	if err != nil {
+		t.Fatalf("Got %v expected %v", err, nil)
+	}
+	assertForest(t, g, result)
+	if actualValue := result.TotalWeight; actualValue != 37 {
+		t.Errorf("Got %v expected %v", actualValue, 37)
+	}
+	if actualValue := len(result.Edges); actualValue != 8 {
+		t.Errorf("Got %v expected %v", actualValue, 8)
+	}
+	if actualValue := result.IsSpanningTree(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue, expectedValue := result.Edges[0], (graph.Edge[int, int]{From: 7, To: 6, Weight: 1}); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestPrim(t *testing.T) {
+	g := newTestGraph()
+	result, err := Prim(g)
+	if err != nil {
+		t.Fatalf("Got %v expected %v", err, nil)
+	}
+	assertForest(t, g, result)
+	if actualValue := result.TotalWeight; actualValue != 37 {
+		t.Errorf("Got %v expected %v", actualValue, 37)
+	}
+	if actualValue, expectedValue := result.Edges[0], (graph.Edge[int, int]{From: 0, To: 1, Weight: 4}); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestForest(t *testing.T) {
+	g := newTestGraph()
+	g.AddWeightedEdge(10, 11, 3)
+	g.AddVertex(12)
+	for _, algorithm := range []func(*graph.Graph[int, int]) (*Result[int, int], error){Kruskal[int, int], Prim[int, int]} {
+		result, _ := algorithm(g)
+		assertForest(t, g, result)
+		if actualValue := result.Trees; actualValue != 3 {
+			t.Errorf("Got %v expected %v", actualValue, 3)
+		}
+		if actualValue := result.TotalWeight; actualValue != 40 {
+			t.Errorf("Got %v expected %v", actualValue, 40)
+		}
+		if actualValue := result.IsSpanningTree(); actualValue != false {
+			t.Errorf("Got %v expected %v", actualValue, false)
+		}
+	}
+}
+
+func TestKruskalMatchesPrim(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	for round := 0; round < 20; round++ {
+		g := graph.NewUndirected[int, int]()
+		for i := 0; i < 100; i++ {
+			g.AddWeightedEdge(random.Intn(30), random.Intn(30), random.Intn(50)-10)
+		}
+		kruskal, _ := Kruskal(g)
+		prim, _ := Prim(g)
+		assertForest(t, g, kruskal)
+		assertForest(t, g, prim)
+		if kruskal.TotalWeight != prim.TotalWeight {
+			t.Fatalf("Got %v expected %v", prim.TotalWeight, kruskal.TotalWeight)
+		}
+	}
+}
+
+func TestFloatWeights(t *testing.T) {
+	g := graph.NewUndirected[string, float64]()
+	g.AddWeightedEdge("a", "b", 0.5)
+	g.AddWeightedEdge("b", "c", 0.25)
+	g.AddWeightedEdge("a", "c", 0.375)
+	result, _ := Kruskal(g)
+	if actualValue := result.TotalWeight; actualValue != 0.625 {
+		t.Errorf("Got %v expected %v", actualValue, 0.625)
+	}
+}
+
+func TestDirected(t *testing.T) {
+	g := graph.NewDirected[int, int]()
+	g.AddEdge(1, 2)
+	if _, err := Kruskal(g); !errors.Is(err, ErrDirected) {
+		t.Errorf("Got %v expected %v", err, ErrDirected)
+	}
+	if _, err := Prim(g); !errors.Is(err, ErrDirected) {
+		t.Errorf("Got %v expected %v", err, ErrDirected)
+	}
+}
@@ -0,0 +1,204 @@
+// Package scc decomposes a directed graph into its strongly connected
+// components, the maximal sets of vertices that can all reach each other.
+//
+// Contracting every component to a single vertex gives the condensation of
+// the graph, which is always a directed acyclic graph.
+//
+// Reference: https://en.wikipedia.org/wiki/Strongly_connected_component
+package scc
+
+import (
+	"slices"
+
+	"github.com/TranThang-2804/golangds/graph"
+	"github.com/TranThang-2804/golangds/stack/linkedliststack"
+)
+
+// Condensation is the graph of the strongly connected components
+type Condensation[V comparable, W graph.Weight] struct {
+	// Components lists the vertices of every component,
+	// in a topological order of the condensation
+	Components [][]V
+	// Component maps every vertex to the index of its component
+	Component map[V]int
+	// DAG has a vertex per component index and an edge between two
+	// components when an edge of the graph joins them, weighted with the
+	// lightest of those edges
+	DAG *graph.Graph[int, W]
+}
+
+// frame is a vertex on the DFS stack and the position of
+// the next outgoing edge to explore
+type frame[V comparable] struct {
+	vertex V
+	next   int
+}
+
+// Tarjan returns the strongly connected components of the graph in a
+// topological order of the condensation, using a single depth first search
+func Tarjan[V comparable, W graph.Weight](g *graph.Graph[V, W]) [][]V {
+	index := make(map[V]int, g.VertexCount())
+	low := make(map[V]int, g.VertexCount())
+	onStack := make(map[V]bool)
+	adjacency := make(map[V][]V, g.VertexCount())
+	components := [][]V{}
+	vertexStack := linkedliststack.New[V]()
+
+	visit := func(vertex V) {
+		index[vertex] = len(index)
+		low[vertex] = index[vertex]
+		adjacency[vertex] = g.Neighbors(vertex)
+		onStack[vertex] = true
+		vertexStack.Push(vertex)
+	}
+
+	for _, root := range g.Vertices() {
+		if _, ok := index[root]; ok {
+			continue
+		}
+
+		visit(root)
+		frames := linkedliststack.New[frame[V]]()
+		frames.Push(frame[V]{vertex: root})
+		for !frames.IsEmpty() {
+			top, _ := frames.Pop()
+			neighbors := adjacency[top.vertex]
+			if top.next < len(neighbors) {
+				frames.Push(frame[V]{vertex: top.vertex, next: top.next + 1})
+				next := neighbors[top.next]
+				if _, ok := index[next]; !ok {
+					visit(next)
+					frames.Push(frame[V]{vertex: next})
+				} else if onStack[next] {
+					low[top.vertex] = min(low[top.vertex], index[next])
+				}
+				continue
+			}
+
+			// The vertex is finished, report its low link to the caller
+			if caller, ok := frames.Peek(); ok {
+				low[caller.vertex] = min(low[caller.vertex], low[top.vertex])
+			}
+			if low[top.vertex] == index[top.vertex] {
+				component := []V{}
+				for {
+					vertex, _ := vertexStack.Pop()
+					onStack[vertex] = false
+					component = append(component, vertex)
+					if vertex == top.vertex {
+						break
+					}
+				}
+				components = append(components, component)
+			}
+		}
+	}
+
+	// Tarjan completes a component after every component it can reach
+	slices.Reverse(components)
+	return components
+}
+
+// Kosaraju returns the strongly connected components of the graph in a
+// topological order of the condensation, using a depth first search on the
+// graph followed by one on its transpose
+func Kosaraju[V comparable, W graph.Weight](g *graph.Graph[V, W]) [][]V {
+	finished := finishingOrder(g)
+	transpose := g.Transpose()
+
+	assigned := make(map[V]bool, g.VertexCount())
+	components := [][]V{}
+	for i := len(finished) - 1; i >= 0; i-- {
+		root := finished[i]
+		if assigned[root] {
+			continue
+		}
+
+		component := []V{}
+		stack := linkedliststack.New[V]()
+		stack.Push(root)
+		assigned[root] = true
+		for !stack.IsEmpty() {
+			vertex, _ := stack.Pop()
+			component = append(component, vertex)
+			for _, next := range transpose.Neighbors(vertex) {
+				if !assigned[next] {
+					assigned[next] = true
+					stack.Push(next)
+				}
+			}
+		}
+		components = append(components, component)
+	}
+	return components
+}
+
+// Condense contracts every strongly connected component of the graph
+func Condense[V comparable, W graph.Weight](g *graph.Graph[V, W]) *Condensation[V, W] {
+	c := &Condensation[V, W]{
+		Components: Tarjan(g),
+		Component:  make(map[V]int, g.VertexCount()),
+		DAG:        graph.NewDirected[int, W](),
+	}
+	for i, component := range c.Components {
+		c.DAG.AddVertex(i)
+		for _, vertex := range component {
+			c.Component[vertex] = i
+		}
+	}
+
+	type link struct{ from, to int }
+	lightest := make(map[link]W)
+	links := []link{}
+	for _, edge := range g.AllEdges() {
+		l := link{from: c.Component[edge.From], to: c.Component[edge.To]}
+		if l.from == l.to {
+			continue
+		}
+		if weight, ok := lightest[l]; !ok {
+			links = append(links, l)
+		} else if weight <= edge.Weight {
+			continue
+		}
+		lightest[l] = edge.Weight
+	}
+	for _, l := range links {
+		c.DAG.AddWeightedEdge(l.from, l.to, lightest[l])
+	}
+	return c
+}
+
+// finishingOrder returns the vertices in the order
+// an iterative depth first search finishes them
+func finishingOrder[V comparable, W graph.Weight](g *graph.Graph[V, W]) []V {
+	visited := make(map[V]bool, g.VertexCount())
+	adjacency := make(map[V][]V, g.VertexCount())
+	finished := make([]V, 0, g.VertexCount())
+
+	for _, root := range g.Vertices() {
+		if visited[root] {
+			continue
+		}
+
+		visited[root] = true
+		adjacency[root] = g.Neighbors(root)
+		stack := linkedliststack.New[frame[V]]()
+		stack.Push(frame[V]{vertex: root})
+		for !stack.IsEmpty() {
+			top, _ := stack.Pop()
+			neighbors := adjacency[top.vertex]
+			if top.next == len(neighbors) {
+				finished = append(finished, top.vertex)
+				continue
+			}
+
+			stack.Push(frame[V]{vertex: top.vertex, next: top.next + 1})
+			if next := neighbors[top.next]; !visited[next] {
+				visited[next] = true
+				adjacency[next] = g.Neighbors(next)
+				stack.Push(frame[V]{vertex: next})
+			}
+		}
+	}
+	return finished
+}
@@ -0,0 +1,151 @@
+package scc
+
+import (
+	"cmp"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/TranThang-2804/golangds/graph"
+	"github.com/TranThang-2804/golangds/graph/topological"
+)
+
+// newTestGraph builds the graph with the components {a b c}, {d e}, {f} and {g}
+//
+//	a -> b -> c -> a
+//	c -> d <-> e -> f
+//	f -> f
+//	g -> a
+func newTestGraph() *graph.Graph[string, int] {
+	g := graph.NewDirected[string, int]()
+	g.AddEdge("a", "b")
+	g.AddEdge("b", "c")
+	g.AddEdge("c", "a")
+	g.AddWeightedEdge("c", "d", 4)
+	g.AddWeightedEdge("b", "e", 3)
+	g.AddEdge("d", "e")
+	g.AddEdge("e", "d")
+	g.AddEdge("e", "f")
+	g.AddEdge("f", "f")
+	g.AddEdge("g", "a")
+	return g
+}
+
+// normalize sorts the vertices of every component and then the components
+func normalize(components [][]string) [][]string {
+	for _, component := range components {
+		slices.Sort(component)
+	}
+	slices.SortFunc(components, func(a, b []string) int {
+		return cmp.Compare(a[0], b[0])
+	})
+	return components
+}
+
+// assertTopological checks that no edge goes from a later component to an earlier one
+func assertTopological[V comparable](t *testing.T, g *graph.Graph[V, int], components [][]V) {
+	t.Helper()
+	position := map[V]int{}
+	for i, component := range components {
+		for _, vertex := range component {
+			position[vertex] = i
+		}
+	}
+	for _, edge := range g.AllEdges() {
+		if position[edge.From] > position[edge.To] {
+			t.Errorf("Components %v are not in topological order", components)
+			return
+		}
+	}
+}
+
+func TestTarjan(t *testing.T) {
+	g := newTestGraph()
+	components := Tarjan(g)
+	assertTopological(t, g, components)
+	expected := [][]string{{"a", "b", "c"}, {"d", "e"}, {"f"}, {"g"}}
+	if actualValue := normalize(components); !slices.EqualFunc(actualValue, expected, slices.Equal) {
+		t.Errorf("Got %v expected %v", actualValue, expected)
+	}
+}
+
+func TestKosaraju(t *testing.T) {
+	g := newTestGraph()
+	components := Kosaraju(g)
+	assertTopological(t, g, components)
+	expected := [][]string{{"a", "b", "c"}, {"d", "e"}, {"f"}, {"g"}}
+	if actualValue := normalize(components); !slices.EqualFunc(actualValue, expected, slices.Equal) {
+		t.Errorf("Got %v expected %v", actualValue, expected)
+	}
+}
+
+func TestTarjanMatchesKosaraju(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	for round := 0; round < 20; round++ {
+		g := graph.NewDirected[int, int]()
+		for i := 0; i < 40; i++ {
+			g.AddVertex(i)
+		}
+		for i := 0; i < 60; i++ {
+			g.AddEdge(random.Intn(40), random.Intn(40))
+		}
+		tarjan, kosaraju := Tarjan(g), Kosaraju(g)
+		assertTopological(t, g, tarjan)
+		assertTopological(t, g, kosaraju)
+		for _, components := range [][][]int{tarjan, kosaraju} {
+			for _, component := range components {
+				slices.Sort(component)
+			}
+			slices.SortFunc(components, func(a, b []int) int { return cmp.Compare(a[0], b[0]) })
+		}
+		if !slices.EqualFunc(tarjan, kosaraju, slices.Equal) {
+			t.Fatalf("Got %v expected %v", tarjan, kosaraju)
+		}
+	}
+}
+
+func TestCondense(t *testing.T) {
+	g := newTestGraph()
+	c := Condense(g)
+	if actualValue := c.DAG.VertexCount(); actualValue != 4 {
+		t.Errorf("Got %v expected %v", actualValue, 4)
+	}
+	if actualValue := c.DAG.EdgeCount(); actualValue != 3 {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	if actualValue := topological.IsAcyclic(c.DAG); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	abc, de := c.Component["a"], c.Component["d"]
+	if actualValue := c.Component["c"]; actualValue != abc {
+		t.Errorf("Got %v expected %v", actualValue, abc)
+	}
+	if actualValue, ok := c.DAG.Weight(abc, de); actualValue != 3 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	if actualValue := c.DAG.HasEdge(c.Component["g"], abc); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	for i, component := range c.Components {
+		for _, vertex := range component {
+			if actualValue := c.Component[vertex]; actualValue != i {
+				t.Errorf("Got %v expected %v", actualValue, i)
+			}
+		}
+	}
+}
+
+func TestTarjanLongCycle(t *testing.T) {
+	// Deep enough that a recursive search would need a large stack
+	g := graph.NewDirected[int, int]()
+	size := 100000
+	for i := 0; i < size; i++ {
+		g.AddEdge(i, (i+1)%size)
+	}
+	if actualValue := len(Tarjan(g)); actualValue != 1 {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	if actualValue := len(Kosaraju(g)); actualValue != 1 {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+}
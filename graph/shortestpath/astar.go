@@ -0,0 +1,60 @@
+package shortestpath
+
+import (
+	"github.com/TranThang-2804/golangds/graph"
+)
+
+// Heuristic estimates the length of the shortest path from the vertex to the
+// target. A* returns a shortest path as long as the estimate never exceeds
+// the real length, and expands every vertex at most once if in addition
+// h(u) <= weight(u, v) + h(v) holds for every edge.
+type Heuristic[V comparable, W graph.Weight] func(vertex V) W
+
+// AStar computes a shortest path from the source to the target, expanding
+// the vertices in order of distance from the source plus heuristic estimate.
+// Every edge weight must be non-negative.
+func AStar[V comparable, W graph.Weight](g *graph.Graph[V, W], source, target V, heuristic Heuristic[V, W]) (Path[V, W], error) {
+	return AStarWith(g, source, target, heuristic, NewHeapQueue[V, W])
+}
+
+// AStarWith is AStar ordering the frontier with
+// the priority queue created by newQueue
+func AStarWith[V comparable, W graph.Weight](g *graph.Graph[V, W], source, target V, heuristic Heuristic[V, W], newQueue QueueFactory[V, W]) (Path[V, W], error) {
+	if !g.HasVertex(source) || !g.HasVertex(target) {
+		return Path[V, W]{}, ErrVertexNotFound
+	}
+
+	result := newResult[V, W](source)
+
+	// Items are ordered by estimated total length, not by distance
+	queue := newQueue(compareItems[V, W])
+	queue.Enqueue(Item[V, W]{Vertex: source, Distance: heuristic(source)})
+	for {
+		item, ok := queue.Dequeue()
+		if !ok {
+			return Path[V, W]{}, ErrUnreachable
+		}
+		distance := result.Distance[item.Vertex]
+		// Skip the entries superseded by a shorter path to the same vertex
+		if item.Distance > distance+heuristic(item.Vertex) {
+			continue
+		}
+		if item.Vertex == target {
+			path, _ := result.PathTo(target)
+			return path, nil
+		}
+
+		for _, edge := range g.Edges(item.Vertex) {
+			if edge.Weight < 0 {
+				return Path[V, W]{}, ErrNegativeWeight
+			}
+			next := distance + edge.Weight
+			if current, ok := result.Distance[edge.To]; ok && current <= next {
+				continue
+			}
+			result.Distance[edge.To] = next
+			result.Parent[edge.To] = item.Vertex
+			queue.Enqueue(Item[V, W]{Vertex: edge.To, Distance: next + heuristic(edge.To)})
+		}
+	}
+}
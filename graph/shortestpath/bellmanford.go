@@ -0,0 +1,78 @@
+package shortestpath
+
+import (
+	"slices"
+
+	"github.com/TranThang-2804/golangds/graph"
+)
+
+// BellmanFord computes the shortest paths from the source to every reachable
+// vertex in O(V*E), negative edge weights are allowed. If a negative cycle is
+// reachable from the source a *NegativeCycleError holding the cycle is returned.
+// In an undirected graph a negative edge is a negative cycle on its own.
+func BellmanFord[V comparable, W graph.Weight](g *graph.Graph[V, W], source V) (*Result[V, W], error) {
+	if !g.HasVertex(source) {
+		return nil, ErrVertexNotFound
+	}
+
+	// Both directions of an undirected edge are relaxed
+	edges := []graph.Edge[V, W]{}
+	for _, vertex := range g.Vertices() {
+		edges = append(edges, g.Edges(vertex)...)
+	}
+
+	result := newResult[V, W](source)
+	for round := 1; round < g.VertexCount(); round++ {
+		if !relaxAll(result, edges) {
+			return result, nil
+		}
+	}
+
+	// Any further improvement means a negative cycle
+	for _, edge := range edges {
+		if !relax(result, edge) {
+			continue
+		}
+
+		// Walking back V parents from a vertex improved in the last
+		// round always ends inside the cycle
+		vertex := edge.To
+		for i := 0; i < g.VertexCount(); i++ {
+			vertex = result.Parent[vertex]
+		}
+		cycle := []V{vertex}
+		for current := result.Parent[vertex]; current != vertex; current = result.Parent[current] {
+			cycle = append(cycle, current)
+		}
+		slices.Reverse(cycle)
+		return nil, &NegativeCycleError[V]{Cycle: cycle}
+	}
+	return result, nil
+}
+
+// relaxAll relaxes every edge once and reports whether a distance improved
+func relaxAll[V comparable, W graph.Weight](result *Result[V, W], edges []graph.Edge[V, W]) bool {
+	improved := false
+	for _, edge := range edges {
+		if relax(result, edge) {
+			improved = true
+		}
+	}
+	return improved
+}
+
+// relax shortens the path to the end of the edge through its start if that
+// is shorter and reports whether it did
+func relax[V comparable, W graph.Weight](result *Result[V, W], edge graph.Edge[V, W]) bool {
+	from, ok := result.Distance[edge.From]
+	if !ok {
+		return false
+	}
+	distance := from + edge.Weight
+	if current, ok := result.Distance[edge.To]; ok && current <= distance {
+		return false
+	}
+	result.Distance[edge.To] = distance
+	result.Parent[edge.To] = edge.From
+	return true
+}
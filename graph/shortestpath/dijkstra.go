@@ -0,0 +1,50 @@
+package shortestpath
+
+import (
+	"github.com/TranThang-2804/golangds/graph"
+)
+
+// Dijkstra computes the shortest paths from the source to every reachable
+// vertex in O((V+E) log V). Every edge weight must be non-negative.
+func Dijkstra[V comparable, W graph.Weight](g *graph.Graph[V, W], source V) (*Result[V, W], error) {
+	return DijkstraWith(g, source, NewHeapQueue[V, W])
+}
+
+// DijkstraWith is Dijkstra ordering the frontier with
+// the priority queue created by newQueue
+func DijkstraWith[V comparable, W graph.Weight](g *graph.Graph[V, W], source V, newQueue QueueFactory[V, W]) (*Result[V, W], error) {
+	if !g.HasVertex(source) {
+		return nil, ErrVertexNotFound
+	}
+
+	result := newResult[V, W](source)
+	settled := make(map[V]bool)
+
+	queue := newQueue(compareItems[V, W])
+	queue.Enqueue(Item[V, W]{Vertex: source})
+	for {
+		item, ok := queue.Dequeue()
+		if !ok {
+			break
+		}
+		// The queue may still hold older, longer entries of a settled vertex
+		if settled[item.Vertex] {
+			continue
+		}
+		settled[item.Vertex] = true
+
+		for _, edge := range g.Edges(item.Vertex) {
+			if edge.Weight < 0 {
+				return nil, ErrNegativeWeight
+			}
+			distance := item.Distance + edge.Weight
+			if current, ok := result.Distance[edge.To]; ok && current <= distance {
+				continue
+			}
+			result.Distance[edge.To] = distance
+			result.Parent[edge.To] = item.Vertex
+			queue.Enqueue(Item[V, W]{Vertex: edge.To, Distance: distance})
+		}
+	}
+	return result, nil
+}
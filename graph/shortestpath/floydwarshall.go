@@ -0,0 +1,111 @@
+package shortestpath
+
+import (
+	"github.com/TranThang-2804/golangds/graph"
+)
+
+// AllPairs holds the shortest paths between every pair of vertices.
+// Row and column i of the matrices belong to Vertices[i].
+type AllPairs[V comparable, W graph.Weight] struct {
+	// Vertices lists the vertices in the order of the matrix rows
+	Vertices []V
+	// Distance[i][j] is the length of the shortest path from
+	// Vertices[i] to Vertices[j], meaningful only if Reachable[i][j]
+	Distance [][]W
+	// Reachable[i][j] tells whether there is a path from Vertices[i] to Vertices[j]
+	Reachable [][]bool
+	// next[i][j] is the index of the vertex following Vertices[i]
+	// on the shortest path to Vertices[j]
+	next  [][]int
+	index map[V]int
+}
+
+// FloydWarshall computes the shortest paths between every pair of vertices
+// in O(V^3), negative edge weights are allowed. ErrNegativeCycle is returned
+// if the graph contains a negative cycle.
+func FloydWarshall[V comparable, W graph.Weight](g *graph.Graph[V, W]) (*AllPairs[V, W], error) {
+	vertices := g.Vertices()
+	n := len(vertices)
+
+	a := &AllPairs[V, W]{
+		Vertices:  vertices,
+		Distance:  make([][]W, n),
+		Reachable: make([][]bool, n),
+		next:      make([][]int, n),
+		index:     make(map[V]int, n),
+	}
+	for i, vertex := range vertices {
+		a.index[vertex] = i
+		a.Distance[i] = make([]W, n)
+		a.Reachable[i] = make([]bool, n)
+		a.next[i] = make([]int, n)
+		a.Reachable[i][i] = true
+		a.next[i][i] = i
+	}
+
+	for i, vertex := range vertices {
+		for _, edge := range g.Edges(vertex) {
+			j := a.index[edge.To]
+			if !a.Reachable[i][j] || edge.Weight < a.Distance[i][j] {
+				a.Distance[i][j] = edge.Weight
+				a.Reachable[i][j] = true
+				a.next[i][j] = j
+			}
+		}
+	}
+
+	for k := 0; k < n; k++ {
+		for i := 0; i < n; i++ {
+			if !a.Reachable[i][k] {
+				continue
+			}
+			for j := 0; j < n; j++ {
+				if !a.Reachable[k][j] {
+					continue
+				}
+				distance := a.Distance[i][k] + a.Distance[k][j]
+				if !a.Reachable[i][j] || distance < a.Distance[i][j] {
+					a.Distance[i][j] = distance
+					a.Reachable[i][j] = true
+					a.next[i][j] = a.next[i][k]
+				}
+			}
+		}
+	}
+
+	for i := 0; i < n; i++ {
+		if a.Distance[i][i] < 0 {
+			return nil, ErrNegativeCycle
+		}
+	}
+	return a, nil
+}
+
+// Get the length of the shortest path between two vertices
+// return true if there is a path else return false
+func (a *AllPairs[V, W]) DistanceBetween(from, to V) (W, bool) {
+	i, okFrom := a.index[from]
+	j, okTo := a.index[to]
+	if !okFrom || !okTo || !a.Reachable[i][j] {
+		var zeroValue W
+		return zeroValue, false
+	}
+	return a.Distance[i][j], true
+}
+
+// Return the shortest path between two vertices
+// return true if there is a path else return false
+func (a *AllPairs[V, W]) PathBetween(from, to V) (Path[V, W], bool) {
+	i, okFrom := a.index[from]
+	j, okTo := a.index[to]
+	if !okFrom || !okTo || !a.Reachable[i][j] {
+		return Path[V, W]{}, false
+	}
+
+	vertices := []V{from}
+	for current := i; current != j; {
+		current = a.next[current][j]
+		vertices = append(vertices, a.Vertices[current])
+	}
+	return Path[V, W]{Vertices: vertices, Distance: a.Distance[i][j]}, true
+}
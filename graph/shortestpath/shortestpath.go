@@ -0,0 +1,118 @@
+// Package shortestpath provides single-source and all-pairs shortest path
+// algorithms over graph.Graph.
+//
+// Dijkstra and A* require non-negative edge weights and order the frontier
+// with a priority queue that can be swapped for any queues.Queue
+// implementation. Bellman-Ford accepts negative weights and reports negative
+// cycles, Floyd-Warshall computes the distance between every pair of vertices.
+//
+// Reference: https://en.wikipedia.org/wiki/Shortest_path_problem
+package shortestpath
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/TranThang-2804/golangds/graph"
+	"github.com/TranThang-2804/golangds/list"
+	queues "github.com/TranThang-2804/golangds/queue"
+	"github.com/TranThang-2804/golangds/queue/priorityqueue"
+)
+
+var (
+	// ErrVertexNotFound is returned when a source or target is not in the graph
+	ErrVertexNotFound = errors.New("shortestpath: vertex not found")
+	// ErrNegativeWeight is returned by Dijkstra and A* when they meet a negative edge
+	ErrNegativeWeight = errors.New("shortestpath: negative edge weight")
+	// ErrNegativeCycle is returned when a negative cycle makes distances undefined
+	ErrNegativeCycle = errors.New("shortestpath: negative cycle")
+	// ErrUnreachable is returned when there is no path to the target
+	ErrUnreachable = errors.New("shortestpath: target unreachable")
+)
+
+// NegativeCycleError reports a negative cycle found by Bellman-Ford,
+// it matches ErrNegativeCycle with errors.Is
+type NegativeCycleError[V comparable] struct {
+	// Cycle lists the vertices of the cycle in edge order,
+	// the last vertex has an edge back to the first one
+	Cycle []V
+}
+
+func (e *NegativeCycleError[V]) Error() string {
+	return fmt.Sprintf("%v: %v", ErrNegativeCycle, e.Cycle)
+}
+
+func (e *NegativeCycleError[V]) Unwrap() error {
+	return ErrNegativeCycle
+}
+
+// Item is a vertex waiting in the priority queue with its tentative distance
+type Item[V comparable, W graph.Weight] struct {
+	Vertex   V
+	Distance W
+}
+
+// QueueFactory creates the priority queue used by Dijkstra and A*. The queue
+// must dequeue the item that compares lowest with the comparator first.
+type QueueFactory[V comparable, W graph.Weight] func(comparator list.Comparator[Item[V, W]]) queues.Queue[Item[V, W]]
+
+// NewHeapQueue is the default QueueFactory, backed by priorityqueue.PriorityQueue
+func NewHeapQueue[V comparable, W graph.Weight](comparator list.Comparator[Item[V, W]]) queues.Queue[Item[V, W]] {
+	return priorityqueue.New(comparator)
+}
+
+// Result holds the shortest paths from a source to every reachable vertex
+type Result[V comparable, W graph.Weight] struct {
+	// Source is the vertex every path starts from
+	Source V
+	// Distance maps every reachable vertex to the length of its shortest path
+	Distance map[V]W
+	// Parent maps every reachable vertex but the source to
+	// its predecessor on the shortest path
+	Parent map[V]V
+}
+
+// Path is a sequence of vertices together with its total weight
+type Path[V comparable, W graph.Weight] struct {
+	Vertices []V
+	Distance W
+}
+
+// Get the length of the shortest path to the vertex
+// return true if the vertex is reachable else return false
+func (r *Result[V, W]) DistanceTo(vertex V) (W, bool) {
+	distance, ok := r.Distance[vertex]
+	return distance, ok
+}
+
+// Return the shortest path from the source to the vertex
+// return true if the vertex is reachable else return false
+func (r *Result[V, W]) PathTo(vertex V) (Path[V, W], bool) {
+	distance, ok := r.Distance[vertex]
+	if !ok {
+		return Path[V, W]{}, false
+	}
+
+	vertices := []V{vertex}
+	for vertex != r.Source {
+		vertex = r.Parent[vertex]
+		vertices = append(vertices, vertex)
+	}
+	slices.Reverse(vertices)
+	return Path[V, W]{Vertices: vertices, Distance: distance}, true
+}
+
+func newResult[V comparable, W graph.Weight](source V) *Result[V, W] {
+	return &Result[V, W]{
+		Source:   source,
+		Distance: map[V]W{source: 0},
+		Parent:   make(map[V]V),
+	}
+}
+
+// compareItems orders queue items by increasing distance
+func compareItems[V comparable, W graph.Weight](a, b Item[V, W]) int {
+	return cmp.Compare(a.Distance, b.Distance)
+}
@@ -0,0 +1,335 @@
+package shortestpath
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/TranThang-2804/golangds/graph"
+	"github.com/TranThang-2804/golangds/list"
+	queues "github.com/TranThang-2804/golangds/queue"
+)
+
+// newRoadGraph builds the directed graph
+//
+//	s -1-> a -3-> t
+//	s -4-> b -1-> t
+//	a -1-> b
+//	x (isolated)
+func newRoadGraph() *graph.Graph[string, int] {
+	g := graph.NewDirected[string, int]()
+	g.AddWeightedEdge("s", "a", 1)
+	g.AddWeightedEdge("s", "b", 4)
+	g.AddWeightedEdge("a", "b", 1)
+	g.AddWeightedEdge("a", "t", 3)
+	g.AddWeightedEdge("b", "t", 1)
+	g.AddVertex("x")
+	return g
+}
+
+// newRandomGraph builds a directed graph of n vertices with random
+// non-negative weights
+func newRandomGraph(seed int64, n, edges int) *graph.Graph[int, int] {
+	random := rand.New(rand.NewSource(seed))
+	g := graph.NewDirected[int, int]()
+	for i := 0; i < n; i++ {
+		g.AddVertex(i)
+	}
+	for i := 0; i < edges; i++ {
+		g.AddWeightedEdge(random.Intn(n), random.Intn(n), random.Intn(20))
+	}
+	return g
+}
+
+// sliceQueue is a linear scan priority queue used to check that
+// the algorithms only rely on the queues.Queue interface
+type sliceQueue[T comparable] struct {
+	items      []T
+	comparator list.Comparator[T]
+	dequeues   int
+}
+
+func (q *sliceQueue[T]) Enqueue(value T) {
+	q.items = append(q.items, value)
+}
+
+func (q *sliceQueue[T]) Dequeue() (T, bool) {
+	value, ok := q.Peek()
+	if ok {
+		q.items = slices.Delete(q.items, slices.Index(q.items, value), slices.Index(q.items, value)+1)
+		q.dequeues++
+	}
+	return value, ok
+}
+
+func (q *sliceQueue[T]) Peek() (T, bool) {
+	if len(q.items) == 0 {
+		var zeroValue T
+		return zeroValue, false
+	}
+	return slices.MinFunc(q.items, q.comparator), true
+}
+
+func TestDijkstra(t *testing.T) {
+	result, err := Dijkstra(newRoadGraph(), "s")
+	if err != nil {
+		t.Fatalf("Got %v expected %v", err, nil)
+	}
+	if actualValue, ok := result.DistanceTo("t"); actualValue != 3 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	if actualValue, ok := result.PathTo("t"); !ok || !slices.Equal(actualValue.Vertices, []string{"s", "a", "b", "t"}) || actualValue.Distance != 3 {
+		t.Errorf("Got %v expected %v", actualValue, []string{"s", "a", "b", "t"})
+	}
+	if actualValue, ok := result.PathTo("s"); !ok || !slices.Equal(actualValue.Vertices, []string{"s"}) {
+		t.Errorf("Got %v expected %v", actualValue, []string{"s"})
+	}
+	if _, ok := result.DistanceTo("x"); ok {
+		t.Errorf("Got %v expected %v", ok, false)
+	}
+	if _, ok := result.PathTo("x"); ok {
+		t.Errorf("Got %v expected %v", ok, false)
+	}
+	if _, err := Dijkstra(newRoadGraph(), "y"); !errors.Is(err, ErrVertexNotFound) {
+		t.Errorf("Got %v expected %v", err, ErrVertexNotFound)
+	}
+}
+
+func TestDijkstraNegativeWeight(t *testing.T) {
+	g := newRoadGraph()
+	g.AddWeightedEdge("b", "a", -1)
+	if _, err := Dijkstra(g, "s"); !errors.Is(err, ErrNegativeWeight) {
+		t.Errorf("Got %v expected %v", err, ErrNegativeWeight)
+	}
+}
+
+func TestDijkstraWithQueue(t *testing.T) {
+	var queue *sliceQueue[Item[string, int]]
+	newQueue := func(comparator list.Comparator[Item[string, int]]) queues.Queue[Item[string, int]] {
+		queue = &sliceQueue[Item[string, int]]{comparator: comparator}
+		return queue
+	}
+	result, err := DijkstraWith(newRoadGraph(), "s", newQueue)
+	if err != nil {
+		t.Fatalf("Got %v expected %v", err, nil)
+	}
+	if actualValue, ok := result.DistanceTo("t"); actualValue != 3 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	if actualValue := queue.dequeues; actualValue == 0 {
+		t.Errorf("Got %v expected more than %v", actualValue, 0)
+	}
+}
+
+func TestDijkstraFloat(t *testing.T) {
+	g := graph.NewUndirected[string, float64]()
+	g.AddWeightedEdge("a", "b", 0.5)
+	g.AddWeightedEdge("b", "c", 0.25)
+	g.AddWeightedEdge("a", "c", 1)
+	result, _ := Dijkstra(g, "c")
+	if actualValue, ok := result.PathTo("a"); !ok || !slices.Equal(actualValue.Vertices, []string{"c", "b", "a"}) || actualValue.Distance != 0.75 {
+		t.Errorf("Got %v expected %v", actualValue, []string{"c", "b", "a"})
+	}
+}
+
+func TestBellmanFord(t *testing.T) {
+	g := newRoadGraph()
+	g.AddWeightedEdge("s", "c", 5)
+	g.AddWeightedEdge("c", "a", -5)
+	result, err := BellmanFord(g, "s")
+	if err != nil {
+		t.Fatalf("Got %v expected %v", err, nil)
+	}
+	if actualValue, ok := result.PathTo("t"); !ok || !slices.Equal(actualValue.Vertices, []string{"s", "c", "a", "b", "t"}) || actualValue.Distance != 2 {
+		t.Errorf("Got %v expected %v", actualValue, []string{"s", "c", "a", "b", "t"})
+	}
+	if _, ok := result.DistanceTo("x"); ok {
+		t.Errorf("Got %v expected %v", ok, false)
+	}
+	if _, err := BellmanFord(g, "y"); !errors.Is(err, ErrVertexNotFound) {
+		t.Errorf("Got %v expected %v", err, ErrVertexNotFound)
+	}
+}
+
+func TestBellmanFordNegativeCycle(t *testing.T) {
+	g := newRoadGraph()
+	g.AddWeightedEdge("t", "c", 1)
+	g.AddWeightedEdge("c", "b", -3)
+	_, err := BellmanFord(g, "s")
+	if !errors.Is(err, ErrNegativeCycle) {
+		t.Fatalf("Got %v expected %v", err, ErrNegativeCycle)
+	}
+	var cycleErr *NegativeCycleError[string]
+	if !errors.As(err, &cycleErr) {
+		t.Fatalf("Got %T expected %T", err, cycleErr)
+	}
+	// The cycle is reported from any of its vertices, rotate it to b first
+	cycle := cycleErr.Cycle
+	start := slices.Index(cycle, "b")
+	if start < 0 {
+		t.Fatalf("Got %v expected a rotation of %v", cycle, []string{"b", "t", "c"})
+	}
+	cycle = append(cycle[start:], cycle[:start]...)
+	if actualValue, expectedValue := cycle, []string{"b", "t", "c"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// A negative cycle that cannot be reached from the source is harmless
+	if _, err := BellmanFord(g, "x"); err != nil {
+		t.Errorf("Got %v expected %v", err, nil)
+	}
+}
+
+func TestBellmanFordMatchesDijkstra(t *testing.T) {
+	g := newRandomGraph(1, 50, 200)
+	expected, _ := Dijkstra(g, 0)
+	actual, err := BellmanFord(g, 0)
+	if err != nil {
+		t.Fatalf("Got %v expected %v", err, nil)
+	}
+	for _, vertex := range g.Vertices() {
+		expectedValue, expectedOk := expected.DistanceTo(vertex)
+		actualValue, actualOk := actual.DistanceTo(vertex)
+		if actualValue != expectedValue || actualOk != expectedOk {
+			t.Errorf("Got %v expected %v for vertex %v", actualValue, expectedValue, vertex)
+		}
+	}
+}
+
+func TestAStar(t *testing.T) {
+	// A 10x10 grid where each vertex is x*10+y, with a wall at x == 5 but for y == 9
+	g := graph.NewUndirected[int, int]()
+	for x := 0; x < 10; x++ {
+		for y := 0; y < 10; y++ {
+			if x == 5 && y != 9 {
+				continue
+			}
+			if x+1 < 10 && !(x+1 == 5 && y != 9) {
+				g.AddEdge(x*10+y, (x+1)*10+y)
+			}
+			if y+1 < 10 {
+				g.AddEdge(x*10+y, x*10+y+1)
+			}
+		}
+	}
+	manhattan := func(vertex int) int {
+		dx, dy := 9-vertex/10, 0-vertex%10
+		return int(math.Abs(float64(dx)) + math.Abs(float64(dy)))
+	}
+
+	path, err := AStar(g, 0, 90, manhattan)
+	if err != nil {
+		t.Fatalf("Got %v expected %v", err, nil)
+	}
+	expected, _ := Dijkstra(g, 0)
+	if actualValue, expectedValue := path.Distance, expected.Distance[90]; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := len(path.Vertices), path.Distance+1; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if !slices.Contains(path.Vertices, 59) {
+		t.Errorf("Path %v should go through the gap %v", path.Vertices, 59)
+	}
+
+	g.AddVertex(100)
+	if _, err := AStar(g, 0, 100, manhattan); !errors.Is(err, ErrUnreachable) {
+		t.Errorf("Got %v expected %v", err, ErrUnreachable)
+	}
+	if _, err := AStar(g, 0, 101, manhattan); !errors.Is(err, ErrVertexNotFound) {
+		t.Errorf("Got %v expected %v", err, ErrVertexNotFound)
+	}
+}
+
+func TestAStarInconsistentHeuristic(t *testing.T) {
+	// The heuristic is admissible but not consistent, so b is first
+	// reached through the longer path and must be expanded again
+	g := graph.NewDirected[string, int]()
+	g.AddWeightedEdge("s", "a", 1)
+	g.AddWeightedEdge("s", "b", 4)
+	g.AddWeightedEdge("a", "b", 1)
+	g.AddWeightedEdge("b", "t", 5)
+	h := map[string]int{"s": 0, "a": 5, "b": 0, "t": 0}
+	path, err := AStar(g, "s", "t", func(vertex string) int { return h[vertex] })
+	if err != nil {
+		t.Fatalf("Got %v expected %v", err, nil)
+	}
+	if actualValue, expectedValue := path.Vertices, []string{"s", "a", "b", "t"}; !slices.Equal(actualValue, expectedValue) || path.Distance != 7 {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestFloydWarshall(t *testing.T) {
+	g := newRoadGraph()
+	g.AddWeightedEdge("t", "s", -2)
+	allPairs, err := FloydWarshall(g)
+	if err != nil {
+		t.Fatalf("Got %v expected %v", err, nil)
+	}
+	if actualValue, ok := allPairs.DistanceBetween("b", "a"); actualValue != 0 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+	if actualValue, ok := allPairs.PathBetween("b", "a"); !ok || !slices.Equal(actualValue.Vertices, []string{"b", "t", "s", "a"}) {
+		t.Errorf("Got %v expected %v", actualValue, []string{"b", "t", "s", "a"})
+	}
+	if actualValue, ok := allPairs.PathBetween("a", "a"); !ok || !slices.Equal(actualValue.Vertices, []string{"a"}) {
+		t.Errorf("Got %v expected %v", actualValue, []string{"a"})
+	}
+	if _, ok := allPairs.DistanceBetween("s", "x"); ok {
+		t.Errorf("Got %v expected %v", ok, false)
+	}
+	if _, ok := allPairs.PathBetween("s", "y"); ok {
+		t.Errorf("Got %v expected %v", ok, false)
+	}
+	if actualValue := len(allPairs.Distance); actualValue != g.VertexCount() {
+		t.Errorf("Got %v expected %v", actualValue, g.VertexCount())
+	}
+
+	g.AddWeightedEdge("b", "a", -2)
+	if _, err := FloydWarshall(g); !errors.Is(err, ErrNegativeCycle) {
+		t.Errorf("Got %v expected %v", err, ErrNegativeCycle)
+	}
+}
+
+func TestFloydWarshallMatchesDijkstra(t *testing.T) {
+	g := newRandomGraph(2, 30, 120)
+	allPairs, _ := FloydWarshall(g)
+	for _, source := range g.Vertices() {
+		expected, _ := Dijkstra(g, source)
+		for _, target := range g.Vertices() {
+			expectedValue, expectedOk := expected.DistanceTo(target)
+			actualValue, actualOk := allPairs.DistanceBetween(source, target)
+			if actualValue != expectedValue || actualOk != expectedOk {
+				t.Fatalf("Got %v expected %v from %v to %v", actualValue, expectedValue, source, target)
+			}
+			if path, ok := allPairs.PathBetween(source, target); ok {
+				length := 0
+				for i := 1; i < len(path.Vertices); i++ {
+					weight, _ := g.Weight(path.Vertices[i-1], path.Vertices[i])
+					length += weight
+				}
+				if length < path.Distance {
+					t.Fatalf("Path %v is shorter than %v", path.Vertices, path.Distance)
+				}
+			}
+		}
+	}
+}
+
+func BenchmarkDijkstra1000(b *testing.B) {
+	g := newRandomGraph(3, 1000, 10000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Dijkstra(g, 0)
+	}
+}
+
+func BenchmarkBellmanFord1000(b *testing.B) {
+	g := newRandomGraph(3, 1000, 10000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		BellmanFord(g, 0)
+	}
+}
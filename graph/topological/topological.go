@@ -0,0 +1,184 @@
+// Package topological orders the vertices of a directed acyclic graph so that
+// every edge goes from an earlier vertex to a later one, and finds the cycles
+// that prevent such an order.
+//
+// Reference: https://en.wikipedia.org/wiki/Topological_sorting
+package topological
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/TranThang-2804/golangds/graph"
+	queues "github.com/TranThang-2804/golangds/queue"
+	"github.com/TranThang-2804/golangds/queue/linkedlistqueue"
+	"github.com/TranThang-2804/golangds/stack/linkedliststack"
+)
+
+var (
+	// ErrCycle is returned when the graph has a cycle and so no topological order
+	ErrCycle = errors.New("topological: graph has a cycle")
+	// ErrUndirected is returned when the graph is undirected
+	ErrUndirected = errors.New("topological: graph is undirected")
+)
+
+// CycleError reports a cycle preventing a topological order,
+// it matches ErrCycle with errors.Is
+type CycleError[V comparable] struct {
+	// Cycle lists the vertices of the cycle in edge order,
+	// the last vertex has an edge back to the first one
+	Cycle []V
+}
+
+func (e *CycleError[V]) Error() string {
+	return fmt.Sprintf("%v: %v", ErrCycle, e.Cycle)
+}
+
+func (e *CycleError[V]) Unwrap() error {
+	return ErrCycle
+}
+
+// Kahn returns a topological order of the graph built by repeatedly removing
+// a vertex without incoming edges, vertices become ready in FIFO order.
+// A *CycleError is returned if the graph has a cycle.
+func Kahn[V comparable, W graph.Weight](g *graph.Graph[V, W]) ([]V, error) {
+	return KahnWith(g, linkedlistqueue.New[V]())
+}
+
+// KahnWith is Kahn taking the ready vertices from the given empty queue, so
+// for example a priority queue yields the lexicographically smallest order
+func KahnWith[V comparable, W graph.Weight](g *graph.Graph[V, W], queue queues.Queue[V]) ([]V, error) {
+	if !g.IsDirected() {
+		return nil, ErrUndirected
+	}
+
+	inDegree := make(map[V]int, g.VertexCount())
+	for _, edge := range g.AllEdges() {
+		inDegree[edge.To]++
+	}
+	for _, vertex := range g.Vertices() {
+		if inDegree[vertex] == 0 {
+			queue.Enqueue(vertex)
+		}
+	}
+
+	order := make([]V, 0, g.VertexCount())
+	for {
+		vertex, ok := queue.Dequeue()
+		if !ok {
+			break
+		}
+		order = append(order, vertex)
+		for _, next := range g.Neighbors(vertex) {
+			inDegree[next]--
+			if inDegree[next] == 0 {
+				queue.Enqueue(next)
+			}
+		}
+	}
+
+	if len(order) != g.VertexCount() {
+		cycle, _ := FindCycle(g)
+		return nil, &CycleError[V]{Cycle: cycle}
+	}
+	return order, nil
+}
+
+// DFS returns a topological order of the graph, the reverse of the order in
+// which a depth first search finishes the vertices.
+// A *CycleError holding the cycle met by the search is returned if the graph has a cycle.
+func DFS[V comparable, W graph.Weight](g *graph.Graph[V, W]) ([]V, error) {
+	if !g.IsDirected() {
+		return nil, ErrUndirected
+	}
+
+	finished, cycle := search(g)
+	if cycle != nil {
+		return nil, &CycleError[V]{Cycle: cycle}
+	}
+	slices.Reverse(finished)
+	return finished, nil
+}
+
+// FindCycle returns the vertices of a cycle of the directed graph in edge order
+// return true if the graph has a cycle else return false
+func FindCycle[V comparable, W graph.Weight](g *graph.Graph[V, W]) ([]V, bool) {
+	if !g.IsDirected() {
+		return nil, false
+	}
+	_, cycle := search(g)
+	return cycle, cycle != nil
+}
+
+// Check if the graph is directed and has no cycle
+func IsAcyclic[V comparable, W graph.Weight](g *graph.Graph[V, W]) bool {
+	if !g.IsDirected() {
+		return false
+	}
+	_, cycle := search(g)
+	return cycle == nil
+}
+
+// vertex states of the depth first search
+const (
+	unvisited = iota
+	onPath
+	done
+)
+
+// frame is a vertex on the DFS stack and the position of
+// the next outgoing edge to explore
+type frame[V comparable] struct {
+	vertex V
+	next   int
+}
+
+// search runs an iterative depth first search over the whole graph and
+// returns the vertices in finishing order, or the first cycle it meets
+func search[V comparable, W graph.Weight](g *graph.Graph[V, W]) ([]V, []V) {
+	state := make(map[V]int, g.VertexCount())
+	parent := make(map[V]V)
+	adjacency := make(map[V][]V, g.VertexCount())
+	finished := make([]V, 0, g.VertexCount())
+
+	for _, root := range g.Vertices() {
+		if state[root] != unvisited {
+			continue
+		}
+
+		state[root] = onPath
+		adjacency[root] = g.Neighbors(root)
+		stack := linkedliststack.New[frame[V]]()
+		stack.Push(frame[V]{vertex: root})
+		for !stack.IsEmpty() {
+			top, _ := stack.Pop()
+			neighbors := adjacency[top.vertex]
+			if top.next == len(neighbors) {
+				state[top.vertex] = done
+				finished = append(finished, top.vertex)
+				continue
+			}
+
+			stack.Push(frame[V]{vertex: top.vertex, next: top.next + 1})
+			next := neighbors[top.next]
+			switch state[next] {
+			case unvisited:
+				state[next] = onPath
+				parent[next] = top.vertex
+				adjacency[next] = g.Neighbors(next)
+				stack.Push(frame[V]{vertex: next})
+			case onPath:
+				// A back edge closes a cycle made of the path from next to here
+				cycle := []V{top.vertex}
+				for current := top.vertex; current != next; {
+					current = parent[current]
+					cycle = append(cycle, current)
+				}
+				slices.Reverse(cycle)
+				return nil, cycle
+			}
+		}
+	}
+	return finished, nil
+}
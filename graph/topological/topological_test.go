@@ -0,0 +1,158 @@
+package topological
+
+import (
+	"cmp"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/TranThang-2804/golangds/graph"
+	"github.com/TranThang-2804/golangds/queue/priorityqueue"
+)
+
+// newBuildGraph builds the dependency graph of a small build,
+// an edge goes from a target to what needs it
+func newBuildGraph() *graph.Graph[string, int] {
+	g := graph.NewDirected[string, int]()
+	g.AddEdge("fetch", "compile")
+	g.AddEdge("configure", "compile")
+	g.AddEdge("compile", "test")
+	g.AddEdge("compile", "package")
+	g.AddEdge("test", "release")
+	g.AddEdge("package", "release")
+	g.AddVertex("docs")
+	return g
+}
+
+// assertTopological checks that every edge of the graph goes forward in the order
+func assertTopological(t *testing.T, g *graph.Graph[string, int], order []string) {
+	t.Helper()
+	if actualValue, expectedValue := len(order), g.VertexCount(); actualValue != expectedValue {
+		t.Fatalf("Got %v expected %v", actualValue, expectedValue)
+	}
+	for _, edge := range g.AllEdges() {
+		if slices.Index(order, edge.From) > slices.Index(order, edge.To) {
+			t.Errorf("Order %v puts %v after %v", order, edge.From, edge.To)
+		}
+	}
+}
+
+// assertCycle checks that the cycle is made of edges of the graph
+func assertCycle(t *testing.T, g *graph.Graph[string, int], cycle []string) {
+	t.Helper()
+	if len(cycle) == 0 {
+		t.Fatalf("Got %v expected a cycle", cycle)
+	}
+	for i, vertex := range cycle {
+		if next := cycle[(i+1)%len(cycle)]; !g.HasEdge(vertex, next) {
+			t.Errorf("Cycle %v uses the missing edge %v -> %v", cycle, vertex, next)
+		}
+	}
+}
+
+func TestKahn(t *testing.T) {
+	g := newBuildGraph()
+	order, err := Kahn(g)
+	if err != nil {
+		t.Fatalf("Got %v expected %v", err, nil)
+	}
+	assertTopological(t, g, order)
+	if actualValue, expectedValue := order, []string{"fetch", "configure", "docs", "compile", "test", "package", "release"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestKahnWithPriorityQueue(t *testing.T) {
+	g := newBuildGraph()
+	order, err := KahnWith(g, priorityqueue.New[string](cmp.Compare[string]))
+	if err != nil {
+		t.Fatalf("Got %v expected %v", err, nil)
+	}
+	if actualValue, expectedValue := order, []string{"configure", "docs", "fetch", "compile", "package", "test", "release"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestKahnCycle(t *testing.T) {
+	g := newBuildGraph()
+	g.AddEdge("release", "configure")
+	_, err := Kahn(g)
+	var cycleErr *CycleError[string]
+	if !errors.As(err, &cycleErr) || !errors.Is(err, ErrCycle) {
+		t.Fatalf("Got %v expected %v", err, ErrCycle)
+	}
+	assertCycle(t, g, cycleErr.Cycle)
+}
+
+func TestDFS(t *testing.T) {
+	g := newBuildGraph()
+	order, err := DFS(g)
+	if err != nil {
+		t.Fatalf("Got %v expected %v", err, nil)
+	}
+	assertTopological(t, g, order)
+}
+
+func TestDFSCycle(t *testing.T) {
+	g := newBuildGraph()
+	g.AddEdge("release", "compile")
+	_, err := DFS(g)
+	var cycleErr *CycleError[string]
+	if !errors.As(err, &cycleErr) {
+		t.Fatalf("Got %v expected %v", err, ErrCycle)
+	}
+	assertCycle(t, g, cycleErr.Cycle)
+	if actualValue, expectedValue := cycleErr.Cycle, []string{"compile", "test", "release"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestFindCycle(t *testing.T) {
+	g := newBuildGraph()
+	if actualValue, ok := FindCycle(g); ok || actualValue != nil {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	if actualValue := IsAcyclic(g); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+
+	g.AddEdge("docs", "docs")
+	if actualValue, ok := FindCycle(g); !ok || !slices.Equal(actualValue, []string{"docs"}) {
+		t.Errorf("Got %v expected %v", actualValue, []string{"docs"})
+	}
+	if actualValue := IsAcyclic(g); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+}
+
+func TestUndirected(t *testing.T) {
+	g := graph.NewUndirected[string, int]()
+	g.AddEdge("a", "b")
+	if _, err := Kahn(g); !errors.Is(err, ErrUndirected) {
+		t.Errorf("Got %v expected %v", err, ErrUndirected)
+	}
+	if _, err := DFS(g); !errors.Is(err, ErrUndirected) {
+		t.Errorf("Got %v expected %v", err, ErrUndirected)
+	}
+	if actualValue := IsAcyclic(g); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+}
+
+func TestDFSLongChain(t *testing.T) {
+	// Deep enough that a recursive search would need a large stack
+	g := graph.NewDirected[int, int]()
+	size := 100000
+	for i := size - 1; i > 0; i-- {
+		g.AddEdge(i-1, i)
+	}
+	order, err := DFS(g)
+	if err != nil {
+		t.Fatalf("Got %v expected %v", err, nil)
+	}
+	for i, vertex := range order {
+		if vertex != i {
+			t.Fatalf("Got %v expected %v", vertex, i)
+		}
+	}
+}
@@ -0,0 +1,125 @@
+package graph
+
+import (
+	"github.com/TranThang-2804/golangds/queue/linkedlistqueue"
+	"github.com/TranThang-2804/golangds/stack/linkedliststack"
+)
+
+// Traversal is the result of walking a graph from a source vertex
+type Traversal[V comparable] struct {
+	// Source is the vertex the walk started from
+	Source V
+	// Order lists the reachable vertices in the order they were visited
+	Order []V
+	// Parent maps every visited vertex but the source to the vertex
+	// it was discovered from, forming the tree of the walk
+	Parent map[V]V
+	// Depth maps every visited vertex to its number of edges
+	// from the source in the tree of the walk
+	Depth map[V]int
+}
+
+// Check if the vertex was reached by the walk
+func (t *Traversal[V]) Visited(vertex V) bool {
+	_, ok := t.Depth[vertex]
+	return ok
+}
+
+// Return the path from the source to the vertex in the tree of the walk
+// return true if the vertex was reached else return false
+func (t *Traversal[V]) PathTo(vertex V) ([]V, bool) {
+	depth, ok := t.Depth[vertex]
+	if !ok {
+		return nil, false
+	}
+
+	path := make([]V, depth+1)
+	for i := depth; i > 0; i-- {
+		path[i] = vertex
+		vertex = t.Parent[vertex]
+	}
+	path[0] = vertex
+	return path, true
+}
+
+// BFS walks the graph breadth first from the source, so the path to every
+// vertex in the returned tree uses the fewest possible edges
+// return true if the source is in the graph else return false
+func (g *Graph[V, W]) BFS(source V) (*Traversal[V], bool) {
+	if !g.HasVertex(source) {
+		return nil, false
+	}
+
+	t := newTraversal(source)
+	t.Depth[source] = 0
+
+	queue := linkedlistqueue.New[V]()
+	queue.Enqueue(source)
+	for !queue.IsEmpty() {
+		vertex, _ := queue.Dequeue()
+		t.Order = append(t.Order, vertex)
+
+		for _, edge := range g.adjacency[g.index[vertex]] {
+			if t.Visited(edge.To) {
+				continue
+			}
+			t.Parent[edge.To] = vertex
+			t.Depth[edge.To] = t.Depth[vertex] + 1
+			queue.Enqueue(edge.To)
+		}
+	}
+	return t, true
+}
+
+// dfsFrame is a vertex waiting on the DFS stack together with
+// the vertex it was reached from
+type dfsFrame[V comparable] struct {
+	vertex    V
+	parent    V
+	hasParent bool
+}
+
+// DFS walks the graph depth first from the source. The visit order is the
+// same as the one of a recursive DFS exploring edges in insertion order.
+// return true if the source is in the graph else return false
+func (g *Graph[V, W]) DFS(source V) (*Traversal[V], bool) {
+	if !g.HasVertex(source) {
+		return nil, false
+	}
+
+	t := newTraversal(source)
+
+	stack := linkedliststack.New[dfsFrame[V]]()
+	stack.Push(dfsFrame[V]{vertex: source})
+	for !stack.IsEmpty() {
+		frame, _ := stack.Pop()
+		if t.Visited(frame.vertex) {
+			continue
+		}
+
+		if frame.hasParent {
+			t.Parent[frame.vertex] = frame.parent
+			t.Depth[frame.vertex] = t.Depth[frame.parent] + 1
+		} else {
+			t.Depth[frame.vertex] = 0
+		}
+		t.Order = append(t.Order, frame.vertex)
+
+		// Push in reverse so that the first edge is explored first
+		edges := g.adjacency[g.index[frame.vertex]]
+		for i := len(edges) - 1; i >= 0; i-- {
+			if !t.Visited(edges[i].To) {
+				stack.Push(dfsFrame[V]{vertex: edges[i].To, parent: frame.vertex, hasParent: true})
+			}
+		}
+	}
+	return t, true
+}
+
+func newTraversal[V comparable](source V) *Traversal[V] {
+	return &Traversal[V]{
+		Source: source,
+		Parent: make(map[V]V),
+		Depth:  make(map[V]int),
+	}
+}
@@ -0,0 +1,106 @@
+package graph
+
+import (
+	"slices"
+	"testing"
+)
+
+// newTestGraph builds
+//
+//	a -> b -> d -> f
+//	|         ^
+//	v         |
+//	c --------+    e (isolated)
+func newTestGraph() *Graph[string, int] {
+	g := NewDirected[string, int]()
+	g.AddEdge("a", "b")
+	g.AddEdge("a", "c")
+	g.AddEdge("b", "d")
+	g.AddEdge("c", "d")
+	g.AddEdge("d", "f")
+	g.AddVertex("e")
+	return g
+}
+
+func TestGraphBFS(t *testing.T) {
+	g := newTestGraph()
+	traversal, ok := g.BFS("a")
+	if !ok {
+		t.Fatalf("Got %v expected %v", ok, true)
+	}
+	if actualValue, expectedValue := traversal.Order, []string{"a", "b", "c", "d", "f"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := traversal.Parent["d"]; actualValue != "b" {
+		t.Errorf("Got %v expected %v", actualValue, "b")
+	}
+	if actualValue := traversal.Depth["f"]; actualValue != 3 {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	if actualValue, ok := traversal.PathTo("f"); !ok || !slices.Equal(actualValue, []string{"a", "b", "d", "f"}) {
+		t.Errorf("Got %v expected %v", actualValue, []string{"a", "b", "d", "f"})
+	}
+	if actualValue, ok := traversal.PathTo("a"); !ok || !slices.Equal(actualValue, []string{"a"}) {
+		t.Errorf("Got %v expected %v", actualValue, []string{"a"})
+	}
+	if actualValue, ok := traversal.PathTo("e"); ok || actualValue != nil {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	if actualValue := traversal.Visited("e"); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if _, ok := g.BFS("x"); ok {
+		t.Errorf("Got %v expected %v", ok, false)
+	}
+}
+
+func TestGraphBFSShortestEdgeCount(t *testing.T) {
+	g := NewUndirected[int, int]()
+	for i := 0; i < 10; i++ {
+		g.AddEdge(i, i+1)
+	}
+	g.AddEdge(0, 10)
+	traversal, _ := g.BFS(0)
+	if actualValue, ok := traversal.PathTo(9); !ok || !slices.Equal(actualValue, []int{0, 10, 9}) {
+		t.Errorf("Got %v expected %v", actualValue, []int{0, 10, 9})
+	}
+}
+
+func TestGraphDFS(t *testing.T) {
+	g := newTestGraph()
+	traversal, ok := g.DFS("a")
+	if !ok {
+		t.Fatalf("Got %v expected %v", ok, true)
+	}
+	if actualValue, expectedValue := traversal.Order, []string{"a", "b", "d", "f", "c"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := traversal.Parent["c"]; actualValue != "a" {
+		t.Errorf("Got %v expected %v", actualValue, "a")
+	}
+	if actualValue, ok := traversal.PathTo("f"); !ok || !slices.Equal(actualValue, []string{"a", "b", "d", "f"}) {
+		t.Errorf("Got %v expected %v", actualValue, []string{"a", "b", "d", "f"})
+	}
+	if _, ok := g.DFS("x"); ok {
+		t.Errorf("Got %v expected %v", ok, false)
+	}
+}
+
+func TestGraphDFSMatchesRecursiveOrder(t *testing.T) {
+	// The iterative walk must not visit a vertex from a stale stack entry:
+	// a recursive DFS reaches c through b, not directly from a
+	g := NewDirected[string, int]()
+	g.AddEdge("a", "b")
+	g.AddEdge("a", "c")
+	g.AddEdge("b", "c")
+	traversal, _ := g.DFS("a")
+	if actualValue, expectedValue := traversal.Order, []string{"a", "b", "c"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := traversal.Parent["c"]; actualValue != "b" {
+		t.Errorf("Got %v expected %v", actualValue, "b")
+	}
+	if actualValue := traversal.Depth["c"]; actualValue != 2 {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+}
@@ -0,0 +1,11 @@
+package list
+
+import "slices"
+
+// Compare compares the items of the lists lexicographically with cmp, a list
+// that is a prefix of the other one being the smaller. The result is
+// negative, zero or positive like the one of cmp, so that a Comparator of
+// lists built on it can sort lists or order them as keys of a tree.
+func Compare[T any](a, b List[T], cmp Comparator[T]) int {
+	return slices.CompareFunc(a.GetAllNode(), b.GetAllNode(), cmp)
+}
@@ -2,60 +2,127 @@ package doublelinkedlist
 
 import (
 	"fmt"
-	"slices"
+	"reflect"
+
+	"github.com/TranThang-2804/golangds/list"
 )
 
+// Comparator compares two items
+//
+// Deprecated: use list.Comparator, which Sort now takes.
 type Comparator[T comparable] func(a, b T) int
 
 // Node is a single element in a linked list.
-type Node[T comparable] struct {
+type Node[T any] struct {
 	value T
 	next  *Node[T]
 	prev  *Node[T]
+	// owner identifies the list the node belongs to, nil once it was removed
+	owner *owner
 }
 
 // DoubleLinkedList struct
-type DoubleLinkedList[T comparable] struct {
+type DoubleLinkedList[T any] struct {
 	head *Node[T]
 	last *Node[T]
 	size int
+	// owner is the owner of the nodes, created on first use
+	owner *owner
+	// modCount counts the structural modifications, which invalidate views
+	modCount int
+	// equal compares items, with reflect.DeepEqual if nil
+	equal func(a, b T) bool
+	// key returns an item as a map key, nil if items have no such key
+	key func(item T) any
 }
 
 // Create a new empty linked list
 func New[T comparable]() *DoubleLinkedList[T] {
-	return &DoubleLinkedList[T]{head: nil, last: nil, size: 0}
+	return &DoubleLinkedList[T]{head: nil, last: nil, size: 0, equal: equalItems[T], key: itemKey[T]}
+}
+
+// NewFunc creates a new empty linked list of items of any type, slices and
+// maps included, that Contains and the other methods looking for items
+// compare with eq. A nil eq compares them with reflect.DeepEqual.
+func NewFunc[T any](eq func(a, b T) bool) *DoubleLinkedList[T] {
+	return &DoubleLinkedList[T]{equal: eq}
+}
+
+// empty returns a new empty list comparing items like the list
+func (l *DoubleLinkedList[T]) empty() *DoubleLinkedList[T] {
+	return &DoubleLinkedList[T]{equal: l.equal, key: l.key}
+}
+
+// equals compares two items like the list
+func (l *DoubleLinkedList[T]) equals(a, b T) bool {
+	if l.equal == nil {
+		return reflect.DeepEqual(a, b)
+	}
+	return l.equal(a, b)
+}
+
+// equalItems compares comparable items with ==
+func equalItems[T comparable](a, b T) bool {
+	return a == b
+}
+
+// itemKey returns a comparable item as a map key
+func itemKey[T comparable](item T) any {
+	return item
 }
 
 // Append a new node to the end of linked list
 func (l *DoubleLinkedList[T]) Append(items ...T) {
 	for _, item := range items {
-		newNode := &Node[T]{value: item, next: nil, prev: nil}
-		if l.size == 0 {
-			l.head = newNode
-		} else {
-			newNode.prev = l.last
-			l.last.next = newNode
-		}
-		l.last = newNode
-		l.size++
+		l.insertAfter(&Node[T]{value: item}, l.last)
 	}
 }
 
 // Append a new node to the beginning of linked list
 func (l *DoubleLinkedList[T]) Prepend(items ...T) {
 	for i := len(items) - 1; i >= 0; i-- {
-		newNode := &Node[T]{value: items[i], next: nil, prev: nil}
-		if l.size == 0 {
-			l.last = newNode
-		} else {
-			l.head.prev = newNode
-			newNode.next = l.head
-		}
-		l.head = newNode
-		l.size++
+		l.insertAfter(&Node[T]{value: items[i]}, nil)
 	}
 }
 
+// insertAfter links the node after mark, or first if mark is nil, and
+// returns it
+func (l *DoubleLinkedList[T]) insertAfter(node, mark *Node[T]) *Node[T] {
+	node.owner, node.prev = l.root(), mark
+	if mark == nil {
+		node.next = l.head
+		l.head = node
+	} else {
+		node.next = mark.next
+		mark.next = node
+	}
+	if node.next == nil {
+		l.last = node
+	} else {
+		node.next.prev = node
+	}
+	l.size++
+	l.modCount++
+	return node
+}
+
+// unlink takes the node out of the list, it still belongs to the list
+func (l *DoubleLinkedList[T]) unlink(node *Node[T]) {
+	if node.prev == nil {
+		l.head = node.next
+	} else {
+		node.prev.next = node.next
+	}
+	if node.next == nil {
+		l.last = node.prev
+	} else {
+		node.next.prev = node.prev
+	}
+	node.next, node.prev = nil, nil
+	l.size--
+	l.modCount++
+}
+
 // Get the item of the link list at the specified
 // err return true if the item is found else return false
 func (l *DoubleLinkedList[T]) Get(index int) (T, bool) {
@@ -64,11 +131,22 @@ func (l *DoubleLinkedList[T]) Get(index int) (T, bool) {
 		return t, false
 	}
 
-	node := l.head
-	for i := 0; i != index; i, node = i+1, node.next {
-	}
+	return l.nodeAt(index).value, true
+}
 
-	return node.value, true
+// nodeAt returns the node at the index, which must be in range, walking from
+// the nearer end of the list so that at most half of it is traversed
+func (l *DoubleLinkedList[T]) nodeAt(index int) *Node[T] {
+	if index < l.size/2 {
+		node := l.head
+		for i := 0; i != index; i, node = i+1, node.next {
+		}
+		return node
+	}
+	node := l.last
+	for i := l.size - 1; i != index; i, node = i-1, node.prev {
+	}
+	return node
 }
 
 // Remove the item of the link list
@@ -77,41 +155,9 @@ func (l *DoubleLinkedList[T]) Remove(index int) bool {
 		return false
 	}
 
-	// If the linked list has only 1 item
-	if l.size == 1 {
-		l.Clear()
-		return true
-	}
-
-	// current node starts at index 1
-	prevNode := l.head
-	currentNode := l.head.next
-
-	switch index {
-	case 0:
-		// If the index is 0, then we need to remove the head
-		l.head = prevNode.next
-		l.head.prev = nil
-		prevNode = nil
-		break
-	case l.size - 1:
-		// If the index is the last item
-		l.last = l.last.prev
-		l.last.next = nil
-	default:
-		// If the index is in the middle of the list
-		for i := 1; i < index; i++ {
-			prevNode = prevNode.next
-			currentNode = currentNode.next
-		}
-
-		prevNode.next = currentNode.next
-		prevNode.next.prev = prevNode
-		currentNode = nil
-		break
-	}
-
-	l.size--
+	node := l.nodeAt(index)
+	l.unlink(node)
+	node.owner = nil
 	return true
 }
 
@@ -119,7 +165,7 @@ func (l *DoubleLinkedList[T]) Remove(index int) bool {
 // return true if the item is found else return false
 func (l *DoubleLinkedList[T]) Contains(item T) bool {
 	for current := l.head; current != nil; current = current.next {
-		if current.value == item {
+		if l.equals(current.value, item) {
 			return true
 		}
 	}
@@ -146,11 +192,80 @@ func (l *DoubleLinkedList[T]) IsEmpty() bool {
 }
 
 // Sort the linked list with the input is a compareFunction
-func (l *DoubleLinkedList[T]) Sort(compareFunction Comparator[T]) {
-	nodeList := l.GetAllNode()
-	slices.SortFunc(nodeList, compareFunction)
-	l.Clear()
-	l.Append(nodeList...)
+// The sort is a stable bottom-up merge sort that relinks the existing nodes,
+// in O(n log n) time and O(1) extra space
+func (l *DoubleLinkedList[T]) Sort(compareFunction list.Comparator[T]) {
+	if l.size < 2 {
+		return
+	}
+	l.modCount++
+
+	// Merge runs of width 1, 2, 4, ... until a single run is left
+	for width := 1; width < l.size; width *= 2 {
+		var head, tail *Node[T]
+		for rest := l.head; rest != nil; {
+			left := rest
+			right := splitNodes(left, width)
+			rest = splitNodes(right, width)
+
+			mergedHead, mergedTail := mergeNodes(left, right, compareFunction)
+			if tail == nil {
+				head = mergedHead
+			} else {
+				tail.next = mergedHead
+				mergedHead.prev = tail
+			}
+			tail = mergedTail
+		}
+		head.prev = nil
+		l.head, l.last = head, tail
+	}
+}
+
+// splitNodes cuts the chain of nodes after n nodes and returns the rest of it
+func splitNodes[T any](node *Node[T], n int) *Node[T] {
+	for i := 1; node != nil && i < n; i++ {
+		node = node.next
+	}
+	if node == nil {
+		return nil
+	}
+	rest := node.next
+	node.next = nil
+	if rest != nil {
+		rest.prev = nil
+	}
+	return rest
+}
+
+// mergeNodes merges two sorted chains of nodes and returns the first and the
+// last node of the result, taking from left on ties so that the merge is stable
+func mergeNodes[T any](left, right *Node[T], compareFunction list.Comparator[T]) (*Node[T], *Node[T]) {
+	var dummy Node[T]
+	tail := &dummy
+	for left != nil && right != nil {
+		if compareFunction(right.value, left.value) < 0 {
+			tail.next, right = right, right.next
+		} else {
+			tail.next, left = left, left.next
+		}
+		tail.next.prev = tail
+		tail = tail.next
+	}
+	if left != nil {
+		tail.next = left
+	} else {
+		tail.next = right
+	}
+	// The remaining nodes are still linked to each other
+	if tail.next != nil {
+		tail.next.prev = tail
+	}
+	for tail.next != nil {
+		tail = tail.next
+	}
+	dummy.next.prev = nil
+	return dummy.next, tail
 }
 
 // Swap 2 items in the linked list
@@ -159,17 +274,7 @@ func (l *DoubleLinkedList[T]) Swap(i, j int) {
 		return
 	}
 
-	var nodeI, nodeJ *Node[T]
-
-	for index, current := 0, l.head; current != nil; index, current = index+1, current.next {
-		if index == i {
-			nodeI = current
-		}
-		if index == j {
-			nodeJ = current
-		}
-	}
-
+	nodeI, nodeJ := l.nodeAt(i), l.nodeAt(j)
 	nodeI.value, nodeJ.value = nodeJ.value, nodeI.value
 }
 
@@ -190,20 +295,12 @@ func (l *DoubleLinkedList[T]) Insert(index int, items ...T) bool {
 		l.Append(items...)
 		break
 	default:
-		// Insert in the middle of the list
-		currentNode := l.head
-
-		// Finding the node corresponding to the index
-		for i := 1; i < index; i++ {
-			currentNode = currentNode.next
-		}
+		// Insert in the middle of the list, after the node before the index
+		currentNode := l.nodeAt(index - 1)
 
 		// Inserting the items
 		for _, item := range items {
-    newNode := &Node[T]{value: item, next: currentNode.next, prev: currentNode}
-			currentNode.next = newNode
-			currentNode = newNode
-			l.size++
+			currentNode = l.insertAfter(&Node[T]{value: item}, currentNode)
 		}
 	}
 	return true
@@ -216,11 +313,7 @@ func (l *DoubleLinkedList[T]) UpdateNodeValue(index int, item T) bool {
 		return false
 	}
 
-	current := l.head
-	for i := 0; i != index; i, current = i+1, current.next {
-	}
-
-	current.value = item
+	l.nodeAt(index).value = item
 	return true
 }
 
@@ -233,8 +326,11 @@ func (l *DoubleLinkedList[T]) String() string {
 	return str
 }
 
-// Clear all item in the linked list
+// Clear all item in the linked list in O(1) time, the handles of its
+// elements become invalid without being unlinked from each other
 func (list *DoubleLinkedList[T]) Clear() {
+	list.owner = nil
+	list.modCount++
 	list.size = 0
 	list.head = nil
 	list.last = nil
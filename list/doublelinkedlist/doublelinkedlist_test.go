@@ -6,10 +6,14 @@ package doublelinkedlist
 
 import (
 	"cmp"
+	"errors"
 	// "encoding/json"
+	"math/rand"
 	"slices"
 	"strings"
 	"testing"
+
+	listpkg "github.com/TranThang-2804/golangds/list"
 )
 
 func TestListNew(t *testing.T) {
@@ -141,6 +145,131 @@ func TestListSort(t *testing.T) {
 	}
 }
 
+type sortItem struct {
+	key   int
+	order int
+}
+
+func TestListSortStable(t *testing.T) {
+	for _, size := range []int{1, 2, 3, 7, 8, 9, 100, 1000} {
+		list := New[sortItem]()
+		for i := 0; i < size; i++ {
+			list.Append(sortItem{key: (i * 7919) % 5, order: i})
+		}
+		list.Sort(func(a, b sortItem) int { return cmp.Compare(a.key, b.key) })
+		if actualValue := list.GetSize(); actualValue != size {
+			t.Errorf("Got %v expected %v", actualValue, size)
+		}
+		items := list.GetAllNode()
+		if actualValue := len(items); actualValue != size {
+			t.Fatalf("Got %v expected %v", actualValue, size)
+		}
+		for i := 1; i < len(items); i++ {
+			a, b := items[i-1], items[i]
+			if a.key > b.key || (a.key == b.key && a.order > b.order) {
+				t.Fatalf("Not stable! %v before %v", a, b)
+			}
+		}
+		if actualValue, expectedValue := list.last.value, items[size-1]; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+}
+
+func TestListSortKeepsNodes(t *testing.T) {
+	list := New[int]()
+	list.Append(5, 3, 9, 1, 7, 3)
+	nodes := map[*Node[int]]bool{}
+	for node := list.head; node != nil; node = node.next {
+		nodes[node] = true
+	}
+	list.Sort(cmp.Compare[int])
+	count := 0
+	for node := list.head; node != nil; node = node.next {
+		if !nodes[node] {
+			t.Errorf("Got a new node for %v", node.value)
+		}
+		count++
+	}
+	if actualValue, expectedValue := count, len(nodes); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	list.Append(10)
+	if actualValue, expectedValue := list.GetAllNode(), []int{1, 3, 3, 5, 7, 9, 10}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestListSortPrevLinks(t *testing.T) {
+	list := New[int]()
+	list.Append(8, 2, 6, 4, 1, 9, 3, 7, 5)
+	list.Sort(cmp.Compare[int])
+	if list.head.prev != nil {
+		t.Errorf("Got %v expected %v", list.head.prev, nil)
+	}
+	var backward []int
+	for node := list.last; node != nil; node = node.prev {
+		backward = append(backward, node.value)
+	}
+	if actualValue, expectedValue := backward, []int{9, 8, 7, 6, 5, 4, 3, 2, 1}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+// checkLinks reports an error if walking the list backward from last does
+// not visit the nodes walked forward from head in reverse
+func checkLinks[T comparable](t *testing.T, list *DoubleLinkedList[T]) {
+	t.Helper()
+	var backward []T
+	for node := list.last; node != nil; node = node.prev {
+		backward = append(backward, node.value)
+	}
+	slices.Reverse(backward)
+	if actualValue, expectedValue := backward, list.GetAllNode(); !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := len(backward), list.GetSize(); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestListIndexFromBothEnds(t *testing.T) {
+	list := New[int]()
+	for n := 0; n < 10; n++ {
+		list.Append(n)
+	}
+	for n := 0; n < 10; n++ {
+		if actualValue, ok := list.Get(n); actualValue != n || !ok {
+			t.Errorf("Got %v expected %v", actualValue, n)
+		}
+	}
+
+	list.UpdateNodeValue(1, 10)
+	list.UpdateNodeValue(8, 80)
+	list.Swap(1, 8)
+	if actualValue, expectedValue := list.GetAllNode(), []int{0, 80, 2, 3, 4, 5, 6, 7, 10, 9}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// Inserting in the second half walks from last and keeps prev links
+	list.Insert(7, 61, 62)
+	list.Insert(2, 11)
+	if actualValue, expectedValue := list.GetAllNode(), []int{0, 80, 11, 2, 3, 4, 5, 6, 61, 62, 7, 10, 9}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	checkLinks(t, list)
+
+	list.Remove(9)
+	list.Remove(2)
+	if actualValue, expectedValue := list.GetAllNode(), []int{0, 80, 2, 3, 4, 5, 6, 61, 7, 10, 9}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	checkLinks(t, list)
+	if actualValue, ok := list.Get(8); actualValue != 7 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 7)
+	}
+}
+
 func TestListClear(t *testing.T) {
 	list := New[string]()
 	list.Append("e", "f", "g", "a", "b", "c", "d")
@@ -656,6 +785,82 @@ func TestListSet(t *testing.T) {
 // 	assert()
 // }
 
+func TestListElements(t *testing.T) {
+	list := New[string]()
+	b := list.PushBackElem("b")
+	a := list.PushFrontElem("a")
+	d := list.PushBackElem("d")
+	c := list.InsertBeforeElem("c", d)
+	e := list.InsertAfterElem("e", d)
+	if actualValue, expectedValue := strings.Join(list.GetAllNode(), ""), "abcde"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if list.Front() != a || list.Back() != e || a.Next() != b || c.Prev() != b {
+		t.Errorf("Got %v expected %v", list.GetAllNode(), "abcde")
+	}
+	if node, ok := list.ElemAt(3); node != d || !ok {
+		t.Errorf("Got %v expected %v", node.Value(), d.Value())
+	}
+	if _, ok := list.ElemAt(5); ok {
+		t.Errorf("Got %v expected %v", ok, false)
+	}
+
+	c.SetValue("C")
+	if actualValue, expectedValue := c.Value(), "C"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	list.MoveToFront(e)
+	list.MoveToBack(a)
+	list.MoveBefore(d, b)
+	list.MoveAfter(b, a)
+	list.MoveBefore(c, c)
+	if actualValue, expectedValue := strings.Join(list.GetAllNode(), ""), "edCab"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	checkLinks(t, list)
+
+	if actualValue, expectedValue := list.RemoveElem(d), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	list.RemoveElem(b)
+	list.RemoveElem(e)
+	if actualValue, expectedValue := strings.Join(list.GetAllNode(), ""), "Ca"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	checkLinks(t, list)
+
+	// Removed elements and elements of other lists are rejected
+	other := New[string]()
+	foreign := other.PushBackElem("x")
+	if list.RemoveElem(d) || list.RemoveElem(foreign) || list.MoveToFront(d) || list.MoveBefore(a, foreign) {
+		t.Errorf("Got %v expected %v", true, false)
+	}
+	if actualValue := list.InsertAfterElem("y", foreign); actualValue != nil {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	if d.Next() != nil || d.Prev() != nil {
+		t.Errorf("Got %v expected %v", d.Next(), nil)
+	}
+	if actualValue, expectedValue := list.GetSize(), 2; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// Handles follow their nodes through Sort and are invalidated by Remove and Clear
+	list.Sort(strings.Compare)
+	if list.Front() != c || list.Back() != a {
+		t.Errorf("Got %v expected %v", list.GetAllNode(), "Ca")
+	}
+	list.Remove(0)
+	if list.RemoveElem(c) {
+		t.Errorf("Got %v expected %v", true, false)
+	}
+	list.Clear()
+	if list.RemoveElem(a) {
+		t.Errorf("Got %v expected %v", true, false)
+	}
+}
+
 func TestListString(t *testing.T) {
 	c := New[int]()
 	c.Append(1)
@@ -664,6 +869,666 @@ func TestListString(t *testing.T) {
 	}
 }
 
+func TestListConcat(t *testing.T) {
+	list, other := New[int](), New[int]()
+	list.Append(1, 2)
+	three := other.PushBackElem(3)
+	other.Append(4)
+	if actualValue, expectedValue := list.Concat(other), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := list.GetAllNode(), []int{1, 2, 3, 4}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := other.GetSize(), 0; actualValue != expectedValue || other.Front() != nil {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	checkLinks(t, list)
+
+	// The elements moved with their nodes
+	if other.RemoveElem(three) {
+		t.Errorf("Got %v expected %v", true, false)
+	}
+	if !list.MoveToFront(three) {
+		t.Errorf("Got %v expected %v", false, true)
+	}
+	// Concatenating again chains the owners
+	third := New[int]()
+	third.Append(5)
+	third.Concat(list)
+	if actualValue, expectedValue := third.GetAllNode(), []int{5, 3, 1, 2, 4}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if list.RemoveElem(three) || !third.RemoveElem(three) {
+		t.Errorf("Got %v expected %v", third.GetAllNode(), []int{5, 1, 2, 4})
+	}
+
+	// Concatenating an empty list or the list itself changes nothing
+	third.Concat(New[int]())
+	if actualValue, expectedValue := third.Concat(third), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	empty := New[int]()
+	empty.Concat(third)
+	if actualValue, expectedValue := empty.GetAllNode(), []int{5, 1, 2, 4}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	checkLinks(t, empty)
+	empty.Append(6)
+	checkLinks(t, empty)
+}
+
+func TestListSplice(t *testing.T) {
+	for _, test := range []struct {
+		index    int
+		expected []int
+	}{
+		{0, []int{7, 8, 1, 2, 3}},
+		{1, []int{1, 7, 8, 2, 3}},
+		{2, []int{1, 2, 7, 8, 3}},
+		{3, []int{1, 2, 3, 7, 8}},
+	} {
+		list, other := New[int](), New[int]()
+		list.Append(1, 2, 3)
+		other.Append(7, 8)
+		if actualValue, expectedValue := list.Splice(test.index, other), true; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := list.GetAllNode(), test.expected; !slices.Equal(actualValue, expectedValue) {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := list.GetSize(), 5; actualValue != expectedValue || !other.IsEmpty() {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		checkLinks(t, list)
+	}
+
+	list := New[int]()
+	list.Append(1)
+	if list.Splice(2, New[int]()) || list.Splice(-1, New[int]()) || list.Splice(0, list) {
+		t.Errorf("Got %v expected %v", true, false)
+	}
+}
+
+func TestListSplitAt(t *testing.T) {
+	for index := 0; index <= 5; index++ {
+		list := New[int]()
+		list.Append(0, 1, 2, 3, 4)
+		elements := make([]*Node[int], 5)
+		for i := range elements {
+			elements[i], _ = list.ElemAt(i)
+		}
+		front, back, ok := list.SplitAt(index)
+		if !ok {
+			t.Fatalf("Got %v expected %v", ok, true)
+		}
+		if actualValue, expectedValue := front.GetAllNode(), []int{0, 1, 2, 3, 4}[:index]; !slices.Equal(actualValue, expectedValue) {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := back.GetAllNode(), []int{0, 1, 2, 3, 4}[index:]; !slices.Equal(actualValue, expectedValue) {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := list.GetSize(), 0; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		checkLinks(t, front)
+		checkLinks(t, back)
+
+		// Every element belongs to the list it went to
+		for i, element := range elements {
+			owner, stranger := front, back
+			if i >= index {
+				owner, stranger = back, front
+			}
+			if list.MoveToBack(element) || stranger.MoveToBack(element) || !owner.MoveToBack(element) {
+				t.Errorf("Got %v in the wrong list", element.Value())
+			}
+		}
+		front.Append(9)
+		back.Append(9)
+		checkLinks(t, front)
+		checkLinks(t, back)
+	}
+
+	list := New[int]()
+	if _, _, ok := list.SplitAt(1); ok {
+		t.Errorf("Got %v expected %v", ok, false)
+	}
+}
+
+func TestListSublist(t *testing.T) {
+	list := New[string]()
+	list.Append("a", "b", "c", "d", "e")
+	c, _ := list.ElemAt(2)
+	sublist, ok := list.Sublist(1, 4)
+	if !ok {
+		t.Fatalf("Got %v expected %v", ok, true)
+	}
+	if actualValue, expectedValue := strings.Join(sublist.GetAllNode(), ""), "bcd"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := strings.Join(list.GetAllNode(), ""), "ae"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if list.RemoveElem(c) || !sublist.RemoveElem(c) {
+		t.Errorf("Got %v in the wrong list", c.Value())
+	}
+	checkLinks(t, list)
+	checkLinks(t, sublist)
+
+	// The ends of the list
+	tail, _ := list.Sublist(1, 2)
+	head, _ := list.Sublist(0, 1)
+	if tail.Front().Value() != "e" || head.Front().Value() != "a" || !list.IsEmpty() {
+		t.Errorf("Got %v %v expected %v %v", head.GetAllNode(), tail.GetAllNode(), "a", "e")
+	}
+	checkLinks(t, list)
+	list.Append("f")
+	checkLinks(t, list)
+
+	if empty, ok := list.Sublist(1, 1); !ok || !empty.IsEmpty() {
+		t.Errorf("Got %v expected %v", ok, true)
+	}
+	for _, bounds := range [][2]int{{-1, 0}, {0, 2}, {1, 0}} {
+		if _, ok := list.Sublist(bounds[0], bounds[1]); ok {
+			t.Errorf("Got %v expected %v", ok, false)
+		}
+	}
+}
+
+func TestListView(t *testing.T) {
+	list := New[int]()
+	list.Append(0, 1, 2, 3, 4, 5)
+	view, ok := list.View(1, 4)
+	if !ok {
+		t.Fatalf("Got %v expected %v", ok, true)
+	}
+	if actualValue, expectedValue := view.GetAllNode(), []int{1, 2, 3}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, ok := view.Get(3); actualValue != 0 || ok {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+	if view.Contains(4) || !view.Contains(3) {
+		t.Errorf("Got %v expected %v", view.GetAllNode(), []int{1, 2, 3})
+	}
+
+	// Writes go through to the list
+	view.Append(10)
+	view.Prepend(11)
+	view.Insert(2, 12)
+	view.Remove(3)
+	view.UpdateNodeValue(0, 13)
+	view.Swap(0, 1)
+	if actualValue, expectedValue := view.GetAllNode(), []int{1, 13, 12, 3, 10}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := list.GetAllNode(), []int{0, 1, 13, 12, 3, 10, 4, 5}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	view.Sort(cmp.Compare[int])
+	if actualValue, expectedValue := list.GetAllNode(), []int{0, 1, 3, 10, 12, 13, 4, 5}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := view.String(), "DoubleLinkedListView\n13101213"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	checkLinks(t, list)
+
+	// Value updates of the list show through and keep the view valid
+	list.UpdateNodeValue(1, 7)
+	list.Swap(2, 3)
+	if actualValue, expectedValue := view.GetAllNode(), []int{7, 10, 3, 12, 13}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	view.Clear()
+	if actualValue, expectedValue := list.GetAllNode(), []int{0, 4, 5}; !slices.Equal(actualValue, expectedValue) || !view.IsEmpty() {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	view.Append(6)
+	if actualValue, expectedValue := list.GetAllNode(), []int{0, 6, 4, 5}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	checkLinks(t, list)
+
+	for _, bounds := range [][2]int{{-1, 0}, {0, 5}, {2, 1}} {
+		if _, ok := list.View(bounds[0], bounds[1]); ok {
+			t.Errorf("Got %v expected %v", ok, false)
+		}
+	}
+}
+
+func TestListViewInvalidated(t *testing.T) {
+	for name, modify := range map[string]func(list *DoubleLinkedList[int]){
+		"Append":   func(list *DoubleLinkedList[int]) { list.Append(9) },
+		"Remove":   func(list *DoubleLinkedList[int]) { list.Remove(0) },
+		"Insert":   func(list *DoubleLinkedList[int]) { list.Insert(1, 9) },
+		"Sort":     func(list *DoubleLinkedList[int]) { list.Sort(cmp.Compare[int]) },
+		"Clear":    func(list *DoubleLinkedList[int]) { list.Clear() },
+		"Concat":   func(list *DoubleLinkedList[int]) { other := New[int](); other.Append(9); list.Concat(other) },
+		"MoveBack": func(list *DoubleLinkedList[int]) { list.MoveToBack(list.Front()) },
+	} {
+		list := New[int]()
+		list.Append(3, 2, 1)
+		view, _ := list.View(0, 2)
+		other, _ := list.View(1, 3)
+		modify(list)
+		for _, v := range []interface{ GetSize() int }{view, other} {
+			func() {
+				defer func() {
+					if err, _ := recover().(error); !errors.Is(err, listpkg.ErrConcurrentModification) {
+						t.Errorf("%s: Got %v expected %v", name, err, listpkg.ErrConcurrentModification)
+					}
+				}()
+				v.GetSize()
+			}()
+		}
+	}
+
+	// A view invalidates the other views of the list
+	list := New[int]()
+	list.Append(1, 2, 3)
+	view, _ := list.View(0, 1)
+	other, _ := list.View(1, 3)
+	view.Append(4)
+	defer func() {
+		if err := recover(); err != listpkg.ErrConcurrentModification {
+			t.Errorf("Got %v expected %v", err, listpkg.ErrConcurrentModification)
+		}
+	}()
+	other.Get(0)
+}
+
+func TestListSearch(t *testing.T) {
+	list := New[int]()
+	list.Append(1, 2, 3, 2, 1)
+
+	for _, test := range []struct {
+		name                  string
+		actual, expectedValue int
+	}{
+		{"IndexOf", list.IndexOf(2), 1},
+		{"IndexOf missing", list.IndexOf(9), -1},
+		{"LastIndexOf", list.LastIndexOf(2), 3},
+		{"LastIndexOf first", list.LastIndexOf(3), 2},
+		{"LastIndexOf missing", list.LastIndexOf(9), -1},
+		{"IndexFunc", list.IndexFunc(func(item int) bool { return item > 2 }), 2},
+		{"IndexFunc none", list.IndexFunc(func(item int) bool { return item > 3 }), -1},
+	} {
+		if test.actual != test.expectedValue {
+			t.Errorf("%s: Got %v expected %v", test.name, test.actual, test.expectedValue)
+		}
+	}
+
+	if actualValue, expectedValue := list.ContainsAll(3, 1, 1), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := list.ContainsAll(1, 4), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := list.ContainsAll(), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// The view searches its window only, with indexes in the view
+	view, _ := list.View(1, 4)
+	if actualValue, expectedValue := view.IndexOf(1), -1; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := view.LastIndexOf(2), 2; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := view.IndexFunc(func(item int) bool { return item == 3 }), 1; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := view.ContainsAll(2, 3), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := view.ContainsAll(1), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestListBulkRemove(t *testing.T) {
+	list := New[int]()
+	list.Append(1, 2, 1, 3, 1, 4, 1)
+
+	if actualValue, expectedValue := list.RemoveValue(1), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := list.RemoveValue(9), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := list.GetAllNode(), []int{2, 1, 3, 1, 4, 1}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	checkLinks(t, list)
+
+	// Removing the last items keeps the end of the list right
+	if actualValue, expectedValue := list.RemoveAll(1), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := list.GetAllNode(), []int{2, 3, 4}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	checkLinks(t, list)
+
+	list.Append(5, 6, 7, 8)
+	even := func(item int) bool { return item%2 == 0 }
+	if actualValue, expectedValue := list.RemoveIf(even), 4; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := list.GetAllNode(), []int{3, 5, 7}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	checkLinks(t, list)
+
+	if actualValue, expectedValue := list.RetainIf(func(item int) bool { return item > 3 }), 1; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := list.GetAllNode(), []int{5, 7}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	checkLinks(t, list)
+
+	if actualValue, expectedValue := list.RetainIf(even), 2; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := list.IsEmpty(), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	checkLinks(t, list)
+
+	// Removed elements are no longer handles of the list
+	list.Append(1, 2)
+	node := list.Front()
+	list.RemoveAll(1)
+	if actualValue, expectedValue := list.MoveToBack(node), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestListViewBulkRemove(t *testing.T) {
+	list := New[int]()
+	list.Append(1, 2, 1, 2, 1, 2)
+	view, _ := list.View(1, 5)
+
+	if actualValue, expectedValue := view.RemoveValue(1), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := view.GetAllNode(), []int{2, 2, 1}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := view.RemoveAll(2), 2; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := view.GetAllNode(), []int{1}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := list.GetAllNode(), []int{1, 1, 2}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	checkLinks(t, list)
+
+	view.Append(3, 4)
+	if actualValue, expectedValue := view.RemoveIf(func(item int) bool { return item > 2 }), 2; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := view.RetainIf(func(item int) bool { return item > 1 }), 1; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := view.GetSize(), 0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := list.GetAllNode(), []int{1, 2}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	checkLinks(t, list)
+}
+
+func TestListReverse(t *testing.T) {
+	for _, items := range [][]int{{}, {1}, {1, 2}, {1, 2, 3, 4, 5}} {
+		list := New[int]()
+		list.Append(items...)
+		list.Reverse()
+		expectedValue := slices.Clone(items)
+		slices.Reverse(expectedValue)
+		if actualValue := list.GetAllNode(); !slices.Equal(actualValue, expectedValue) {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		checkLinks(t, list)
+	}
+
+	// The handles stay valid
+	list := New[int]()
+	list.Append(1, 2, 3)
+	front := list.Front()
+	list.Reverse()
+	if actualValue, expectedValue := list.Back(), front; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	list.MoveToFront(front)
+	if actualValue, expectedValue := list.GetAllNode(), []int{1, 3, 2}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestListRotate(t *testing.T) {
+	for _, test := range []struct {
+		k             int
+		expectedValue []int
+	}{
+		{0, []int{1, 2, 3, 4, 5}},
+		{1, []int{5, 1, 2, 3, 4}},
+		{2, []int{4, 5, 1, 2, 3}},
+		{4, []int{2, 3, 4, 5, 1}},
+		{5, []int{1, 2, 3, 4, 5}},
+		{7, []int{4, 5, 1, 2, 3}},
+		{-1, []int{2, 3, 4, 5, 1}},
+		{-6, []int{2, 3, 4, 5, 1}},
+	} {
+		list := New[int]()
+		list.Append(1, 2, 3, 4, 5)
+		list.Rotate(test.k)
+		if actualValue := list.GetAllNode(); !slices.Equal(actualValue, test.expectedValue) {
+			t.Errorf("Rotate(%d): Got %v expected %v", test.k, actualValue, test.expectedValue)
+		}
+		checkLinks(t, list)
+	}
+
+	list := New[int]()
+	list.Rotate(3)
+	if actualValue, expectedValue := list.IsEmpty(), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestListShuffle(t *testing.T) {
+	items := make([]int, 100)
+	for i := range items {
+		items[i] = i
+	}
+	list, other := New[int](), New[int]()
+	list.Append(items...)
+	other.Append(items...)
+
+	// The same seed gives the same order
+	list.Shuffle(rand.NewSource(42))
+	other.Shuffle(rand.NewSource(42))
+	if actualValue, expectedValue := list.GetAllNode(), other.GetAllNode(); !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := list.GetAllNode(); slices.Equal(actualValue, items) {
+		t.Errorf("Got %v expected a different order", actualValue)
+	}
+	checkLinks(t, list)
+
+	// A permutation of the items
+	list.Shuffle(nil)
+	actualValue := list.GetAllNode()
+	slices.Sort(actualValue)
+	if !slices.Equal(actualValue, items) {
+		t.Errorf("Got %v expected %v", actualValue, items)
+	}
+	checkLinks(t, list)
+}
+
+func TestListDedupe(t *testing.T) {
+	list := New[int]()
+	list.Append(1, 1, 2, 1, 3, 3, 3, 2, 2)
+	if actualValue, expectedValue := list.Dedupe(), 4; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := list.GetAllNode(), []int{1, 2, 1, 3, 2}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	checkLinks(t, list)
+
+	if actualValue, expectedValue := list.DedupeAll(), 2; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := list.GetAllNode(), []int{1, 2, 3}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	checkLinks(t, list)
+
+	// The zero value is not mistaken for an item before the first one
+	list.Clear()
+	list.Append(0, 0, 1)
+	if actualValue, expectedValue := list.Dedupe(), 1; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := list.GetAllNode(), []int{0, 1}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestListClone(t *testing.T) {
+	list := New[int]()
+	list.Append(1, 2, 3)
+	clone := list.Clone()
+	if actualValue, expectedValue := clone.GetAllNode(), []int{1, 2, 3}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	checkLinks(t, clone)
+
+	// The clone and the list change independently
+	clone.Append(4)
+	list.UpdateNodeValue(0, 9)
+	if actualValue, expectedValue := list.GetAllNode(), []int{9, 2, 3}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := clone.GetAllNode(), []int{1, 2, 3, 4}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := clone.RemoveElem(list.Front()), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	if actualValue, expectedValue := New[int]().Clone().IsEmpty(), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestListEqual(t *testing.T) {
+	list, other := New[int](), New[int]()
+	list.Append(1, 2, 3)
+	other.Append(1, 2, 3)
+	if actualValue, expectedValue := list.Equal(other), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	other.Append(4)
+	if actualValue, expectedValue := list.Equal(other), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// Views compare with lists and lists with views
+	view, _ := other.View(0, 3)
+	if actualValue, expectedValue := list.Equal(view), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := view.Equal(list), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	view, _ = other.View(1, 4)
+	if actualValue, expectedValue := view.Equal(list), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	parity := func(a, b int) bool { return a%2 == b%2 }
+	if actualValue, expectedValue := view.EqualFunc(list, parity), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	other.Clear()
+	other.Append(3, 4, 5)
+	if actualValue, expectedValue := list.EqualFunc(other, parity), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := New[int]().Equal(New[int]()), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestListNewFunc(t *testing.T) {
+	// Slices are not comparable, a nil eq compares them with reflect.DeepEqual
+	list := NewFunc[[]int](nil)
+	list.Append([]int{1}, []int{2, 3}, []int{1}, []int{1}, []int{4})
+	if actualValue, expectedValue := list.Contains([]int{2, 3}), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := list.LastIndexOf([]int{1}), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := list.ContainsAll([]int{4}, []int{1}), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := list.ContainsAll([]int{4}, []int{2}), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := list.Dedupe(), 1; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := list.DedupeAll(), 1; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := list.String(), "DoubleLinkedList\n[1][2 3][4]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// Clones and sublists compare items like the list
+	clone := list.Clone()
+	if actualValue, expectedValue := clone.Equal(list), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	sublist, _ := clone.Sublist(1, 3)
+	if actualValue, expectedValue := sublist.RemoveValue([]int{4}), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	view, _ := list.View(0, 2)
+	if actualValue, expectedValue := view.IndexOf([]int{2, 3}), 1; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// A custom eq
+	words := NewFunc(strings.EqualFold)
+	words.Append("Go", "go", "GO", "list")
+	if actualValue, expectedValue := words.RemoveAll("gO"), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := words.Contains("LIST"), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	other := New[string]()
+	other.Append("List")
+	if actualValue, expectedValue := words.Equal(other), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := other.Equal(words), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
 func benchmarkGet(b *testing.B, list *DoubleLinkedList[int], size int) {
 	for i := 0; i < b.N; i++ {
 		for n := 0; n < size; n++ {
@@ -688,6 +1553,33 @@ func benchmarkRemove(b *testing.B, list *DoubleLinkedList[int], size int) {
 	}
 }
 
+func benchmarkSet(b *testing.B, list *DoubleLinkedList[int], size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			list.UpdateNodeValue(n, n)
+		}
+	}
+}
+
+func benchmarkSwap(b *testing.B, list *DoubleLinkedList[int], size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			list.Swap(n, size-1-n)
+		}
+	}
+}
+
+// benchmarkInsert inserts at every index and removes the value again so that
+// the size stays the same
+func benchmarkInsert(b *testing.B, list *DoubleLinkedList[int], size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			list.Insert(n, n)
+			list.Remove(n)
+		}
+	}
+}
+
 func BenchmarkDoublyLinkedListGet100(b *testing.B) {
 	b.StopTimer()
 	size := 100
@@ -816,3 +1708,135 @@ func BenchmarkDoublyLinkedListRemove100000(b *testing.B) {
 	b.StartTimer()
 	benchmarkRemove(b, list, size)
 }
+
+func BenchmarkDoublyLinkedListSet100(b *testing.B) {
+	b.StopTimer()
+	size := 100
+	list := New[int]()
+	for n := 0; n < size; n++ {
+		list.Append(n)
+	}
+	b.StartTimer()
+	benchmarkSet(b, list, size)
+}
+
+func BenchmarkDoublyLinkedListSet1000(b *testing.B) {
+	b.StopTimer()
+	size := 1000
+	list := New[int]()
+	for n := 0; n < size; n++ {
+		list.Append(n)
+	}
+	b.StartTimer()
+	benchmarkSet(b, list, size)
+}
+
+func BenchmarkDoublyLinkedListSet10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	list := New[int]()
+	for n := 0; n < size; n++ {
+		list.Append(n)
+	}
+	b.StartTimer()
+	benchmarkSet(b, list, size)
+}
+
+func BenchmarkDoublyLinkedListSet100000(b *testing.B) {
+	b.StopTimer()
+	size := 100000
+	list := New[int]()
+	for n := 0; n < size; n++ {
+		list.Append(n)
+	}
+	b.StartTimer()
+	benchmarkSet(b, list, size)
+}
+
+func BenchmarkDoublyLinkedListSwap100(b *testing.B) {
+	b.StopTimer()
+	size := 100
+	list := New[int]()
+	for n := 0; n < size; n++ {
+		list.Append(n)
+	}
+	b.StartTimer()
+	benchmarkSwap(b, list, size)
+}
+
+func BenchmarkDoublyLinkedListSwap1000(b *testing.B) {
+	b.StopTimer()
+	size := 1000
+	list := New[int]()
+	for n := 0; n < size; n++ {
+		list.Append(n)
+	}
+	b.StartTimer()
+	benchmarkSwap(b, list, size)
+}
+
+func BenchmarkDoublyLinkedListSwap10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	list := New[int]()
+	for n := 0; n < size; n++ {
+		list.Append(n)
+	}
+	b.StartTimer()
+	benchmarkSwap(b, list, size)
+}
+
+func BenchmarkDoublyLinkedListSwap100000(b *testing.B) {
+	b.StopTimer()
+	size := 100000
+	list := New[int]()
+	for n := 0; n < size; n++ {
+		list.Append(n)
+	}
+	b.StartTimer()
+	benchmarkSwap(b, list, size)
+}
+
+func BenchmarkDoublyLinkedListInsert100(b *testing.B) {
+	b.StopTimer()
+	size := 100
+	list := New[int]()
+	for n := 0; n < size; n++ {
+		list.Append(n)
+	}
+	b.StartTimer()
+	benchmarkInsert(b, list, size)
+}
+
+func BenchmarkDoublyLinkedListInsert1000(b *testing.B) {
+	b.StopTimer()
+	size := 1000
+	list := New[int]()
+	for n := 0; n < size; n++ {
+		list.Append(n)
+	}
+	b.StartTimer()
+	benchmarkInsert(b, list, size)
+}
+
+func BenchmarkDoublyLinkedListInsert10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	list := New[int]()
+	for n := 0; n < size; n++ {
+		list.Append(n)
+	}
+	b.StartTimer()
+	benchmarkInsert(b, list, size)
+}
+
+func BenchmarkDoublyLinkedListInsert100000(b *testing.B) {
+	b.StopTimer()
+	size := 100000
+	list := New[int]()
+	for n := 0; n < size; n++ {
+		list.Append(n)
+	}
+	b.StartTimer()
+	benchmarkInsert(b, list, size)
+}
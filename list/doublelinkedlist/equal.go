@@ -0,0 +1,49 @@
+package doublelinkedlist
+
+import "github.com/TranThang-2804/golangds/list"
+
+// Clone returns a new list with the items of the list, in O(n) time. The
+// nodes of the clone are new, the elements of the list are not its elements.
+func (l *DoubleLinkedList[T]) Clone() *DoubleLinkedList[T] {
+	clone := l.empty()
+	for node := l.head; node != nil; node = node.next {
+		clone.insertAfter(&Node[T]{value: node.value}, clone.last)
+	}
+	return clone
+}
+
+// Equal checks if the other list holds equal items in the same order
+func (l *DoubleLinkedList[T]) Equal(other list.List[T]) bool {
+	return l.EqualFunc(other, l.equals)
+}
+
+// EqualFunc checks if the other list holds as many items as the list, each
+// one equal with eq to the item at the same index
+func (l *DoubleLinkedList[T]) EqualFunc(other list.List[T], eq func(a, b T) bool) bool {
+	return equalFunc(l.head, l.size, other, eq)
+}
+
+// equalFunc checks if the other list holds as many items as the count nodes
+// from first, each one equal with eq to the value of the node at its index
+func equalFunc[T any](first *Node[T], count int, other list.List[T], eq func(a, b T) bool) bool {
+	if other.GetSize() != count {
+		return false
+	}
+	// The nodes of another DoubleLinkedList are walked instead of copying its items
+	if o, ok := other.(*DoubleLinkedList[T]); ok {
+		for i, node, otherNode := 0, first, o.head; i < count; i, node, otherNode = i+1, node.next, otherNode.next {
+			if !eq(node.value, otherNode.value) {
+				return false
+			}
+		}
+		return true
+	}
+	node := first
+	for _, item := range other.GetAllNode() {
+		if !eq(node.value, item) {
+			return false
+		}
+		node = node.next
+	}
+	return true
+}
@@ -0,0 +1,113 @@
+package doublelinkedlist
+
+import "slices"
+
+// ContainsAll checks if the linked list contains every item, in one pass
+func (l *DoubleLinkedList[T]) ContainsAll(items ...T) bool {
+	return l.containsAll(l.head, l.size, items)
+}
+
+// IndexOf returns the index of the first item equal to the item
+// return -1 if the linked list does not contain it
+func (l *DoubleLinkedList[T]) IndexOf(item T) int {
+	return indexFunc(l.head, l.size, func(value T) bool { return l.equals(value, item) })
+}
+
+// LastIndexOf returns the index of the last item equal to the item, walking
+// from the end
+// return -1 if the linked list does not contain it
+func (l *DoubleLinkedList[T]) LastIndexOf(item T) int {
+	return lastIndexFunc(l.last, l.size, func(value T) bool { return l.equals(value, item) })
+}
+
+// IndexFunc returns the index of the first item for which f is true
+// return -1 if there is none
+func (l *DoubleLinkedList[T]) IndexFunc(f func(T) bool) int {
+	return indexFunc(l.head, l.size, f)
+}
+
+// RemoveValue removes the first item equal to the item
+// return false if the linked list does not contain it
+func (l *DoubleLinkedList[T]) RemoveValue(item T) bool {
+	return l.removeFunc(l.head, l.size, func(value T) bool { return l.equals(value, item) }, 1) == 1
+}
+
+// RemoveAll removes every item equal to the item in one pass and returns
+// the number of items removed
+func (l *DoubleLinkedList[T]) RemoveAll(item T) int {
+	return l.removeFunc(l.head, l.size, func(value T) bool { return l.equals(value, item) }, -1)
+}
+
+// RemoveIf removes every item for which f is true in one pass and returns
+// the number of items removed
+func (l *DoubleLinkedList[T]) RemoveIf(f func(T) bool) int {
+	return l.removeFunc(l.head, l.size, f, -1)
+}
+
+// RetainIf removes every item for which f is false in one pass and returns
+// the number of items removed
+func (l *DoubleLinkedList[T]) RetainIf(f func(T) bool) int {
+	return l.removeFunc(l.head, l.size, func(value T) bool { return !f(value) }, -1)
+}
+
+// removeFunc removes up to limit nodes, all of them if limit is negative,
+// for which f is true among the count nodes from first, and returns the
+// number of nodes removed
+func (l *DoubleLinkedList[T]) removeFunc(first *Node[T], count int, f func(T) bool, limit int) int {
+	removed := 0
+	for i, node := 0, first; i < count && removed != limit; i++ {
+		next := node.next
+		if f(node.value) {
+			l.unlink(node)
+			node.owner = nil
+			removed++
+		}
+		node = next
+	}
+	return removed
+}
+
+// indexFunc returns the index of the first of the count nodes from first
+// for which f is true
+// return -1 if there is none
+func indexFunc[T any](first *Node[T], count int, f func(T) bool) int {
+	for i, node := 0, first; i < count; i, node = i+1, node.next {
+		if f(node.value) {
+			return i
+		}
+	}
+	return -1
+}
+
+// lastIndexFunc returns the index of the last of the count nodes up to last
+// for which f is true
+// return -1 if there is none
+func lastIndexFunc[T any](last *Node[T], count int, f func(T) bool) int {
+	for i, node := count-1, last; i >= 0; i, node = i-1, node.prev {
+		if f(node.value) {
+			return i
+		}
+	}
+	return -1
+}
+
+// containsAll checks if the count nodes from first hold every item. Items
+// with a key are looked up in a set, others are compared with every item
+// still missing.
+func (l *DoubleLinkedList[T]) containsAll(first *Node[T], count int, items []T) bool {
+	if l.key == nil {
+		missing := slices.Clone(items)
+		for i, node := 0, first; i < count && len(missing) > 0; i, node = i+1, node.next {
+			missing = slices.DeleteFunc(missing, func(item T) bool { return l.equals(node.value, item) })
+		}
+		return len(missing) == 0
+	}
+	missing := make(map[any]struct{}, len(items))
+	for _, item := range items {
+		missing[l.key(item)] = struct{}{}
+	}
+	for i, node := 0, first; i < count && len(missing) > 0; i, node = i+1, node.next {
+		delete(missing, l.key(node.value))
+	}
+	return len(missing) == 0
+}
@@ -0,0 +1,123 @@
+package doublelinkedlist
+
+// Concat moves the items of other to the end of the list in O(1) time,
+// leaving other empty. The elements of other become elements of the list.
+// return false if other is the list itself
+func (l *DoubleLinkedList[T]) Concat(other *DoubleLinkedList[T]) bool {
+	if other == l {
+		return false
+	}
+	l.spliceAfter(l.last, other)
+	return true
+}
+
+// Splice moves the items of other into the list at the specified index,
+// leaving other empty. Only finding the index takes time, from the nearer
+// end, moving the items takes O(1). The elements of other become elements
+// of the list.
+// return false if the index is out of range or other is the list itself
+func (l *DoubleLinkedList[T]) Splice(index int, other *DoubleLinkedList[T]) bool {
+	if index < 0 || index > l.size || other == l {
+		return false
+	}
+	var prev *Node[T]
+	if index > 0 {
+		prev = l.nodeAt(index - 1)
+	}
+	l.spliceAfter(prev, other)
+	return true
+}
+
+// spliceAfter links the nodes of other after prev, first if prev is nil
+func (l *DoubleLinkedList[T]) spliceAfter(prev *Node[T], other *DoubleLinkedList[T]) {
+	if other.size == 0 {
+		return
+	}
+	// The nodes of other now resolve to the owner of the list
+	other.owner.parent = l.root()
+	other.head.prev = prev
+	if prev == nil {
+		other.last.next = l.head
+		l.head = other.head
+	} else {
+		other.last.next = prev.next
+		prev.next = other.head
+	}
+	if other.last.next == nil {
+		l.last = other.last
+	} else {
+		other.last.next.prev = other.last
+	}
+	l.size += other.size
+	l.modCount++
+	other.head, other.last, other.size, other.owner = nil, nil, 0, nil
+	other.modCount++
+}
+
+// SplitAt moves the items before the index to a first new list and the
+// others to a second one, leaving the list empty. It takes time in the
+// length of the shorter one. The elements of the list become elements of
+// the new lists.
+// return false if the index is out of range
+func (l *DoubleLinkedList[T]) SplitAt(index int) (*DoubleLinkedList[T], *DoubleLinkedList[T], bool) {
+	if index < 0 || index > l.size {
+		return nil, nil, false
+	}
+	// Only the nodes of the shorter part change owner, the others keep the
+	// owner of the list
+	var front, back *DoubleLinkedList[T]
+	if index < l.size-index {
+		front, _ = l.Sublist(0, index)
+	} else {
+		back, _ = l.Sublist(index, l.size)
+	}
+	rest := l.empty()
+	rest.head, rest.last, rest.size, rest.owner = l.head, l.last, l.size, l.owner
+	l.head, l.last, l.size, l.owner = nil, nil, 0, nil
+	l.modCount++
+	if front == nil {
+		front = rest
+	} else {
+		back = rest
+	}
+	return front, back, true
+}
+
+// Sublist moves the items from index from included to index to excluded
+// out of the list into a new list. Finding index from takes time from the
+// nearer end, then moving the items takes time in their number. Their
+// elements become elements of the new list.
+// return false if the range is out of bounds
+func (l *DoubleLinkedList[T]) Sublist(from, to int) (*DoubleLinkedList[T], bool) {
+	if from < 0 || to > l.size || from > to {
+		return nil, false
+	}
+	sublist := l.empty()
+	if from == to {
+		return sublist, true
+	}
+
+	first := l.nodeAt(from)
+	last := first
+	last.owner = sublist.root()
+	for i := from + 1; i < to; i++ {
+		last = last.next
+		last.owner = sublist.owner
+	}
+
+	if first.prev == nil {
+		l.head = last.next
+	} else {
+		first.prev.next = last.next
+	}
+	if last.next == nil {
+		l.last = first.prev
+	} else {
+		last.next.prev = first.prev
+	}
+	first.prev, last.next = nil, nil
+	l.size -= to - from
+	l.modCount++
+	sublist.head, sublist.last, sublist.size = first, last, to-from
+	return sublist, true
+}
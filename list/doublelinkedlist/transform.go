@@ -0,0 +1,116 @@
+package doublelinkedlist
+
+import (
+	"math/rand"
+	"slices"
+)
+
+// The transformations below relink the existing nodes like Sort, so the
+// handles of the elements stay valid, and take O(n) time.
+
+// Reverse reverses the order of the items by swapping the links of every node
+func (l *DoubleLinkedList[T]) Reverse() {
+	if l.size < 2 {
+		return
+	}
+	for node := l.head; node != nil; node = node.prev {
+		node.next, node.prev = node.prev, node.next
+	}
+	l.head, l.last = l.last, l.head
+	l.modCount++
+}
+
+// Rotate moves every item k places towards the end, the last k items
+// wrapping around to the beginning. A negative k rotates towards the
+// beginning. Finding the new first node takes time from the nearer end.
+func (l *DoubleLinkedList[T]) Rotate(k int) {
+	if l.size < 2 {
+		return
+	}
+	k %= l.size
+	if k < 0 {
+		k += l.size
+	}
+	if k == 0 {
+		return
+	}
+	head := l.nodeAt(l.size - k)
+	l.last.next, l.head.prev = l.head, l.last
+	l.head, l.last = head, head.prev
+	l.head.prev, l.last.next = nil, nil
+	l.modCount++
+}
+
+// Shuffle puts the items in a random order drawn from src, in O(n) extra
+// space. Passing a source seeded with a fixed value, rand.NewSource(seed),
+// gives the same order on every run; a nil src uses the default source.
+func (l *DoubleLinkedList[T]) Shuffle(src rand.Source) {
+	if l.size < 2 {
+		return
+	}
+	intn := rand.Intn
+	if src != nil {
+		intn = rand.New(src).Intn
+	}
+	nodes := make([]*Node[T], 0, l.size)
+	for node := l.head; node != nil; node = node.next {
+		nodes = append(nodes, node)
+	}
+	// Fisher-Yates shuffle
+	for i := len(nodes) - 1; i > 0; i-- {
+		j := intn(i + 1)
+		nodes[i], nodes[j] = nodes[j], nodes[i]
+	}
+	var prev *Node[T]
+	for _, node := range nodes {
+		node.prev = prev
+		if prev != nil {
+			prev.next = node
+		}
+		prev = node
+	}
+	prev.next = nil
+	l.head, l.last = nodes[0], prev
+	l.modCount++
+}
+
+// Dedupe removes the items equal to the item before them, keeping the first
+// of every run of equal items, and returns the number of items removed
+func (l *DoubleLinkedList[T]) Dedupe() int {
+	var last T
+	kept := false
+	return l.removeFunc(l.head, l.size, func(item T) bool {
+		if kept && l.equals(item, last) {
+			return true
+		}
+		last, kept = item, true
+		return false
+	}, -1)
+}
+
+// DedupeAll removes the items equal to an item before them anywhere in the
+// list, keeping the first occurrence of every item, in O(n) extra space, and
+// returns the number of items removed. The items of a list created with
+// NewFunc have no hash, they are compared with every item kept, in O(n²)
+// time.
+func (l *DoubleLinkedList[T]) DedupeAll() int {
+	if l.key == nil {
+		var kept []T
+		return l.removeFunc(l.head, l.size, func(item T) bool {
+			if slices.ContainsFunc(kept, func(keptItem T) bool { return l.equals(keptItem, item) }) {
+				return true
+			}
+			kept = append(kept, item)
+			return false
+		}, -1)
+	}
+	seen := make(map[any]struct{}, l.size)
+	return l.removeFunc(l.head, l.size, func(item T) bool {
+		key := l.key(item)
+		if _, ok := seen[key]; ok {
+			return true
+		}
+		seen[key] = struct{}{}
+		return false
+	}, -1)
+}
@@ -0,0 +1,261 @@
+package doublelinkedlist
+
+import (
+	"fmt"
+
+	"github.com/TranThang-2804/golangds/list"
+)
+
+// View returns a list over the items of the list from index from included
+// to index to excluded, without copying them. Reads go to the list and
+// writes through the view change it, the view growing or shrinking with
+// them. Once the list is structurally modified other than through the view,
+// by adding, removing or reordering nodes, any use of the view panics with
+// list.ErrConcurrentModification. Updating values does not invalidate it.
+// return false if the range is out of bounds
+func (l *DoubleLinkedList[T]) View(from, to int) (list.List[T], bool) {
+	if from < 0 || to > l.size || from > to {
+		return nil, false
+	}
+	return &view[T]{parent: l, from: from, size: to - from, modCount: l.modCount}, true
+}
+
+// view is a window of a list from index from
+type view[T any] struct {
+	parent *DoubleLinkedList[T]
+	from   int
+	size   int
+	// modCount is the count of modifications of the parent the view is
+	// valid for
+	modCount int
+}
+
+// check panics if the parent was modified other than through the view
+func (v *view[T]) check() {
+	if v.parent.modCount != v.modCount {
+		panic(list.ErrConcurrentModification)
+	}
+}
+
+// sync records the modifications of the parent made through the view
+func (v *view[T]) sync(added int) {
+	v.size += added
+	v.modCount = v.parent.modCount
+}
+
+// first returns the first node of the view
+// return nil if the view is empty
+func (v *view[T]) first() *Node[T] {
+	if v.size == 0 {
+		return nil
+	}
+	return v.parent.nodeAt(v.from)
+}
+
+// last returns the last node of the view
+// return nil if the view is empty
+func (v *view[T]) last() *Node[T] {
+	if v.size == 0 {
+		return nil
+	}
+	return v.parent.nodeAt(v.from + v.size - 1)
+}
+
+// Append items at the end of the view
+func (v *view[T]) Append(items ...T) {
+	v.check()
+	v.parent.Insert(v.from+v.size, items...)
+	v.sync(len(items))
+}
+
+// Prepend items at the beginning of the view
+func (v *view[T]) Prepend(items ...T) {
+	v.check()
+	v.parent.Insert(v.from, items...)
+	v.sync(len(items))
+}
+
+// Get the item of the view at the specified index
+// return false if the index is out of range of the view
+func (v *view[T]) Get(index int) (T, bool) {
+	v.check()
+	if index < 0 || index >= v.size {
+		var t T
+		return t, false
+	}
+	return v.parent.Get(v.from + index)
+}
+
+// Remove the item of the view at the specified index
+// return false if the index is out of range of the view
+func (v *view[T]) Remove(index int) bool {
+	v.check()
+	if index < 0 || index >= v.size {
+		return false
+	}
+	v.parent.Remove(v.from + index)
+	v.sync(-1)
+	return true
+}
+
+// Check if the view contains the item
+func (v *view[T]) Contains(item T) bool {
+	v.check()
+	for i, node := 0, v.first(); i < v.size; i, node = i+1, node.next {
+		if v.parent.equals(node.value, item) {
+			return true
+		}
+	}
+	return false
+}
+
+// Return an array of all the items of the view
+func (v *view[T]) GetAllNode() []T {
+	v.check()
+	var items []T
+	for i, node := 0, v.first(); i < v.size; i, node = i+1, node.next {
+		items = append(items, node.value)
+	}
+	return items
+}
+
+// Check if the view contains every item, in one pass
+func (v *view[T]) ContainsAll(items ...T) bool {
+	v.check()
+	return v.parent.containsAll(v.first(), v.size, items)
+}
+
+// IndexOf returns the index in the view of the first item equal to the item
+// return -1 if the view does not contain it
+func (v *view[T]) IndexOf(item T) int {
+	v.check()
+	return indexFunc(v.first(), v.size, func(value T) bool { return v.parent.equals(value, item) })
+}
+
+// LastIndexOf returns the index in the view of the last item equal to the item
+// return -1 if the view does not contain it
+func (v *view[T]) LastIndexOf(item T) int {
+	v.check()
+	return lastIndexFunc(v.last(), v.size, func(value T) bool { return v.parent.equals(value, item) })
+}
+
+// IndexFunc returns the index in the view of the first item for which f is true
+// return -1 if there is none
+func (v *view[T]) IndexFunc(f func(T) bool) int {
+	v.check()
+	return indexFunc(v.first(), v.size, f)
+}
+
+// RemoveValue removes the first item of the view equal to the item
+// return false if the view does not contain it
+func (v *view[T]) RemoveValue(item T) bool {
+	return v.removeFunc(func(value T) bool { return v.parent.equals(value, item) }, 1) == 1
+}
+
+// RemoveAll removes every item of the view equal to the item in one pass and
+// returns the number of items removed
+func (v *view[T]) RemoveAll(item T) int {
+	return v.removeFunc(func(value T) bool { return v.parent.equals(value, item) }, -1)
+}
+
+// RemoveIf removes every item of the view for which f is true in one pass and
+// returns the number of items removed
+func (v *view[T]) RemoveIf(f func(T) bool) int {
+	return v.removeFunc(f, -1)
+}
+
+// RetainIf removes every item of the view for which f is false in one pass
+// and returns the number of items removed
+func (v *view[T]) RetainIf(f func(T) bool) int {
+	return v.removeFunc(func(value T) bool { return !f(value) }, -1)
+}
+
+// removeFunc removes up to limit items of the view, all of them if limit is
+// negative, for which f is true
+func (v *view[T]) removeFunc(f func(T) bool, limit int) int {
+	v.check()
+	removed := v.parent.removeFunc(v.first(), v.size, f, limit)
+	v.sync(-removed)
+	return removed
+}
+
+// Check if the other list holds equal items in the same order as the view
+func (v *view[T]) Equal(other list.List[T]) bool {
+	return v.EqualFunc(other, v.parent.equals)
+}
+
+// Check if the other list holds as many items as the view, each one equal
+// with eq to the item of the view at the same index
+func (v *view[T]) EqualFunc(other list.List[T], eq func(a, b T) bool) bool {
+	v.check()
+	return equalFunc(v.first(), v.size, other, eq)
+}
+
+// Get the number of items of the view
+func (v *view[T]) GetSize() int {
+	v.check()
+	return v.size
+}
+
+// Check if the view is empty
+func (v *view[T]) IsEmpty() bool {
+	return v.GetSize() == 0
+}
+
+// Sort the items of the view, relinking the nodes of the list like its Sort
+func (v *view[T]) Sort(compareFunction list.Comparator[T]) {
+	v.check()
+	sublist, _ := v.parent.Sublist(v.from, v.from+v.size)
+	sublist.Sort(compareFunction)
+	v.parent.Splice(v.from, sublist)
+	v.sync(0)
+}
+
+// Swap 2 items of the view
+func (v *view[T]) Swap(i, j int) {
+	v.check()
+	if i < 0 || i >= v.size || j < 0 || j >= v.size {
+		return
+	}
+	v.parent.Swap(v.from+i, v.from+j)
+}
+
+// Insert items at the specified index of the view
+// return false if the index is out of range of the view
+func (v *view[T]) Insert(index int, items ...T) bool {
+	v.check()
+	if index < 0 || index > v.size {
+		return false
+	}
+	v.parent.Insert(v.from+index, items...)
+	v.sync(len(items))
+	return true
+}
+
+// Update the value of the item at the specified index of the view
+// return false if the index is out of range of the view
+func (v *view[T]) UpdateNodeValue(index int, item T) bool {
+	v.check()
+	if index < 0 || index >= v.size {
+		return false
+	}
+	return v.parent.UpdateNodeValue(v.from+index, item)
+}
+
+// Go through the view and return the values as string
+func (v *view[T]) String() string {
+	str := "DoubleLinkedListView\n"
+	for _, item := range v.GetAllNode() {
+		str += fmt.Sprintf("%v", item)
+	}
+	return str
+}
+
+// Clear removes the items of the view from the list
+func (v *view[T]) Clear() {
+	v.check()
+	if v.size > 0 {
+		v.parent.Sublist(v.from, v.from+v.size)
+	}
+	v.sync(-v.size)
+}
@@ -0,0 +1,185 @@
+package linkedlist
+
+// The methods below work with nodes as handles to elements of the list, in
+// the manner of container/list. A handle stays valid while its element is in
+// the list, across Sort which relinks the nodes, until it is removed with
+// RemoveElem, Remove or Clear. Swap and UpdateNodeValue change the values of
+// nodes, not the nodes. Clear does not walk the nodes: the list rejects
+// their handles afterwards, but Next still returns their old neighbours, so
+// handles should not be followed once their list is cleared.
+//
+// Nodes only link to the next one, so the operations that take a node out
+// of its place, RemoveElem and the moves, find the node before it in O(n)
+// time. DoubleLinkedList does all of them in O(1).
+
+// Value returns the value of the element
+func (n *Node[T]) Value() T {
+	return n.value
+}
+
+// SetValue replaces the value of the element
+func (n *Node[T]) SetValue(item T) {
+	n.value = item
+}
+
+// Next returns the next element
+// return nil if the element is the last one or was removed, except by Clear
+// which leaves the elements it drops linked to each other
+func (n *Node[T]) Next() *Node[T] {
+	return n.next
+}
+
+// Front returns the first element
+// return nil if the list is empty
+func (l *LinkedList[T]) Front() *Node[T] {
+	return l.head
+}
+
+// Back returns the last element
+// return nil if the list is empty
+func (l *LinkedList[T]) Back() *Node[T] {
+	return l.last
+}
+
+// ElemAt returns the element at the index
+// return false if the index is out of range
+func (l *LinkedList[T]) ElemAt(index int) (*Node[T], bool) {
+	if index < 0 || index >= l.size {
+		return nil, false
+	}
+	node := l.head
+	for i := 0; i != index; i, node = i+1, node.next {
+	}
+	return node, true
+}
+
+// PushFrontElem adds the item at the beginning of the list and returns its element
+func (l *LinkedList[T]) PushFrontElem(item T) *Node[T] {
+	return l.insertAfter(&Node[T]{value: item}, nil)
+}
+
+// PushBackElem adds the item at the end of the list and returns its element
+func (l *LinkedList[T]) PushBackElem(item T) *Node[T] {
+	return l.insertAfter(&Node[T]{value: item}, l.last)
+}
+
+// InsertAfterElem adds the item right after mark in O(1) time and returns
+// its element
+// return nil if mark is not an element of the list
+func (l *LinkedList[T]) InsertAfterElem(item T, mark *Node[T]) *Node[T] {
+	if !l.owns(mark) {
+		return nil
+	}
+	return l.insertAfter(&Node[T]{value: item}, mark)
+}
+
+// RemoveElem removes the element from the list, in O(n) time to find the
+// node before it
+// return false if it is not an element of the list
+func (l *LinkedList[T]) RemoveElem(node *Node[T]) bool {
+	if !l.owns(node) {
+		return false
+	}
+	l.unlinkAfter(l.nodeBefore(node), node)
+	node.owner = nil
+	return true
+}
+
+// MoveToFront moves the element to the beginning of the list, in O(n) time
+// to find the node before it
+// return false if it is not an element of the list
+func (l *LinkedList[T]) MoveToFront(node *Node[T]) bool {
+	if !l.owns(node) {
+		return false
+	}
+	if node != l.head {
+		l.unlinkAfter(l.nodeBefore(node), node)
+		l.insertAfter(node, nil)
+	}
+	return true
+}
+
+// MoveToBack moves the element to the end of the list, in O(n) time to find
+// the node before it
+// return false if it is not an element of the list
+func (l *LinkedList[T]) MoveToBack(node *Node[T]) bool {
+	if !l.owns(node) {
+		return false
+	}
+	if node != l.last {
+		l.unlinkAfter(l.nodeBefore(node), node)
+		l.insertAfter(node, l.last)
+	}
+	return true
+}
+
+// MoveBefore moves the element right before mark, in O(n) time to find the
+// nodes before both
+// return false if either is not an element of the list
+func (l *LinkedList[T]) MoveBefore(node, mark *Node[T]) bool {
+	if !l.owns(node) || !l.owns(mark) {
+		return false
+	}
+	if node != mark {
+		l.unlinkAfter(l.nodeBefore(node), node)
+		l.insertAfter(node, l.nodeBefore(mark))
+	}
+	return true
+}
+
+// MoveAfter moves the element right after mark, in O(n) time to find the
+// node before the element
+// return false if either is not an element of the list
+func (l *LinkedList[T]) MoveAfter(node, mark *Node[T]) bool {
+	if !l.owns(node) || !l.owns(mark) {
+		return false
+	}
+	if node != mark {
+		l.unlinkAfter(l.nodeBefore(node), node)
+		l.insertAfter(node, mark)
+	}
+	return true
+}
+
+// owns checks if the node is an element of the list
+func (l *LinkedList[T]) owns(node *Node[T]) bool {
+	return node != nil && node.owner != nil && l.owner != nil && node.owner.find() == l.owner
+}
+
+// root returns the owner of the nodes of the list
+func (l *LinkedList[T]) root() *owner {
+	if l.owner == nil {
+		l.owner = &owner{}
+	}
+	return l.owner
+}
+
+// owner identifies a list to its nodes. A list that takes the nodes of
+// another one in O(1) time, with Concat or Splice, makes the owner of those
+// nodes point to its own instead of updating every node, and Clear drops
+// the owner of the list instead of walking its nodes.
+type owner struct {
+	parent *owner
+}
+
+// find returns the owner of the list, the one at the end of the parents,
+// halving the path to it on the way
+func (o *owner) find() *owner {
+	for o.parent != nil {
+		if o.parent.parent != nil {
+			o.parent = o.parent.parent
+		}
+		o = o.parent
+	}
+	return o
+}
+
+// nodeBefore returns the node before the node of the list
+// return nil if it is the first one
+func (l *LinkedList[T]) nodeBefore(node *Node[T]) *Node[T] {
+	var prev *Node[T]
+	for current := l.head; current != node; current = current.next {
+		prev = current
+	}
+	return prev
+}
@@ -2,55 +2,116 @@ package linkedlist
 
 import (
 	"fmt"
-	"slices"
+	"reflect"
 
 	"github.com/TranThang-2804/golangds/list"
 )
 
 // Node is a single element in a linked list.
-type Node[T comparable] struct {
+type Node[T any] struct {
 	value T
 	next  *Node[T]
+	// owner identifies the list the node belongs to, nil once it was removed
+	owner *owner
 }
 
 // LinkedList struct
-type LinkedList[T comparable] struct {
+type LinkedList[T any] struct {
 	head *Node[T]
 	last *Node[T]
 	size int
+	// owner is the owner of the nodes, created on first use
+	owner *owner
+	// modCount counts the structural modifications, which invalidate views
+	modCount int
+	// equal compares items, with reflect.DeepEqual if nil
+	equal func(a, b T) bool
+	// key returns an item as a map key, nil if items have no such key
+	key func(item T) any
 }
 
 // Create a new empty linked list
 func New[T comparable]() *LinkedList[T] {
-	return &LinkedList[T]{head: nil, last: nil, size: 0}
+	return &LinkedList[T]{head: nil, last: nil, size: 0, equal: equalItems[T], key: itemKey[T]}
+}
+
+// NewFunc creates a new empty linked list of items of any type, slices and
+// maps included, that Contains and the other methods looking for items
+// compare with eq. A nil eq compares them with reflect.DeepEqual.
+func NewFunc[T any](eq func(a, b T) bool) *LinkedList[T] {
+	return &LinkedList[T]{equal: eq}
+}
+
+// empty returns a new empty list comparing items like the list
+func (l *LinkedList[T]) empty() *LinkedList[T] {
+	return &LinkedList[T]{equal: l.equal, key: l.key}
+}
+
+// equals compares two items like the list
+func (l *LinkedList[T]) equals(a, b T) bool {
+	if l.equal == nil {
+		return reflect.DeepEqual(a, b)
+	}
+	return l.equal(a, b)
+}
+
+// equalItems compares comparable items with ==
+func equalItems[T comparable](a, b T) bool {
+	return a == b
+}
+
+// itemKey returns a comparable item as a map key
+func itemKey[T comparable](item T) any {
+	return item
 }
 
 // Append a new node to the end of linked list
 func (l *LinkedList[T]) Append(items ...T) {
 	for _, item := range items {
-		newNode := &Node[T]{value: item, next: nil}
-		if l.size == 0 {
-			l.head = newNode
-		} else {
-			l.last.next = newNode
-		}
-		l.last = newNode
-		l.size++
+		l.insertAfter(&Node[T]{value: item}, l.last)
 	}
 }
 
 // Append a new node to the beginning of linked list
 func (l *LinkedList[T]) Prepend(items ...T) {
 	for i := len(items) - 1; i >= 0; i-- {
-		newNode := &Node[T]{value: items[i], next: nil}
-		if l.size == 0 {
-			l.last = newNode
-		} else {
-			newNode.next = l.head
-		}
-		l.head = newNode
-		l.size++
+		l.insertAfter(&Node[T]{value: items[i]}, nil)
+	}
+}
+
+// insertAfter links the node after mark, or first if mark is nil, and
+// returns it
+func (l *LinkedList[T]) insertAfter(node, mark *Node[T]) *Node[T] {
+	node.owner = l.root()
+	if mark == nil {
+		node.next = l.head
+		l.head = node
+	} else {
+		node.next = mark.next
+		mark.next = node
 	}
+	if node.next == nil {
+		l.last = node
+	}
+	l.size++
+	l.modCount++
+	return node
+}
+
+// unlinkAfter takes the node out of the list given the node before it, nil
+// if it is the first one. It still belongs to the list.
+func (l *LinkedList[T]) unlinkAfter(prev, node *Node[T]) {
+	if prev == nil {
+		l.head = node.next
+	} else {
+		prev.next = node.next
+	}
+	if node.next == nil {
+		l.last = prev
+	}
+	node.next = nil
+	l.size--
+	l.modCount++
 }
 
 // Get the item of the link list at the specified
@@ -74,25 +135,15 @@ func (l *LinkedList[T]) Remove(index int) bool {
 		return false
 	}
 
-	// current node starts at index 1
-	prevNode := l.head
-	currentNode := l.head.next
-
-	// If the index is 0, then we need to remove the head
-	if index == 0 {
-		l.head = prevNode.next
-		prevNode = nil
-	} else {
-		for i := 1; i < index; i++ {
-			prevNode = prevNode.next
-			currentNode = currentNode.next
-		}
-
-		prevNode.next = currentNode.next
-		currentNode = nil
+	// The head has no node before it
+	var prevNode *Node[T]
+	currentNode := l.head
+	for i := 0; i < index; i++ {
+		prevNode, currentNode = currentNode, currentNode.next
 	}
 
-	l.size--
+	l.unlinkAfter(prevNode, currentNode)
+	currentNode.owner = nil
 	return true
 }
 
@@ -100,7 +151,7 @@ func (l *LinkedList[T]) Remove(index int) bool {
 // return true if the item is found else return false
 func (l *LinkedList[T]) Contains(item T) bool {
 	for current := l.head; current != nil; current = current.next {
-		if current.value == item {
+		if l.equals(current.value, item) {
 			return true
 		}
 	}
@@ -126,11 +177,70 @@ func (l *LinkedList[T]) IsEmpty() bool {
 	return l.size == 0
 }
 
+// Sort the linked list with the input is a compareFunction
+// The sort is a stable bottom-up merge sort that relinks the existing nodes,
+// in O(n log n) time and O(1) extra space
 func (l *LinkedList[T]) Sort(compareFunction list.Comparator[T]) {
-	nodeList := l.GetAllNode()
-	slices.SortFunc(nodeList, compareFunction)
-	l.Clear()
-	l.Append(nodeList...)
+	if l.size < 2 {
+		return
+	}
+	l.modCount++
+
+	// Merge runs of width 1, 2, 4, ... until a single run is left
+	for width := 1; width < l.size; width *= 2 {
+		var head, tail *Node[T]
+		for rest := l.head; rest != nil; {
+			left := rest
+			right := splitNodes(left, width)
+			rest = splitNodes(right, width)
+
+			mergedHead, mergedTail := mergeNodes(left, right, compareFunction)
+			if tail == nil {
+				head = mergedHead
+			} else {
+				tail.next = mergedHead
+			}
+			tail = mergedTail
+		}
+		l.head, l.last = head, tail
+	}
+}
+
+// splitNodes cuts the chain of nodes after n nodes and returns the rest of it
+func splitNodes[T any](node *Node[T], n int) *Node[T] {
+	for i := 1; node != nil && i < n; i++ {
+		node = node.next
+	}
+	if node == nil {
+		return nil
+	}
+	rest := node.next
+	node.next = nil
+	return rest
+}
+
+// mergeNodes merges two sorted chains of nodes and returns the first and the
+// last node of the result, taking from left on ties so that the merge is stable
+func mergeNodes[T any](left, right *Node[T], compareFunction list.Comparator[T]) (*Node[T], *Node[T]) {
+	var dummy Node[T]
+	tail := &dummy
+	for left != nil && right != nil {
+		if compareFunction(right.value, left.value) < 0 {
+			tail.next, right = right, right.next
+		} else {
+			tail.next, left = left, left.next
+		}
+		tail = tail.next
+	}
+	if left != nil {
+		tail.next = left
+	} else {
+		tail.next = right
+	}
+	for tail.next != nil {
+		tail = tail.next
+	}
+	return dummy.next, tail
 }
 
 // Swap 2 items in the linked list
@@ -180,10 +290,7 @@ func (l *LinkedList[T]) Insert(index int, items ...T) bool {
 
 		// Inserting the items
 		for _, item := range items {
-			newNode := &Node[T]{value: item, next: currentNode.next}
-			currentNode.next = newNode
-			currentNode = newNode
-			l.size++
+			currentNode = l.insertAfter(&Node[T]{value: item}, currentNode)
 		}
 	}
 	return true
@@ -213,8 +320,11 @@ func (l *LinkedList[T]) String() string {
 	return str
 }
 
-// Clear all item in the linked list
+// Clear all item in the linked list in O(1) time, the handles of its
+// elements become invalid without being unlinked from each other
 func (list *LinkedList[T]) Clear() {
+	list.owner = nil
+	list.modCount++
 	list.size = 0
 	list.head = nil
 	list.last = nil
@@ -0,0 +1,119 @@
+package linkedlist
+
+import "slices"
+
+// ContainsAll checks if the linked list contains every item, in one pass
+func (l *LinkedList[T]) ContainsAll(items ...T) bool {
+	return l.containsAll(l.head, l.size, items)
+}
+
+// IndexOf returns the index of the first item equal to the item
+// return -1 if the linked list does not contain it
+func (l *LinkedList[T]) IndexOf(item T) int {
+	return indexFunc(l.head, l.size, func(value T) bool { return l.equals(value, item) })
+}
+
+// LastIndexOf returns the index of the last item equal to the item
+// return -1 if the linked list does not contain it
+func (l *LinkedList[T]) LastIndexOf(item T) int {
+	return lastIndexFunc(l.head, l.size, func(value T) bool { return l.equals(value, item) })
+}
+
+// IndexFunc returns the index of the first item for which f is true
+// return -1 if there is none
+func (l *LinkedList[T]) IndexFunc(f func(T) bool) int {
+	return indexFunc(l.head, l.size, f)
+}
+
+// RemoveValue removes the first item equal to the item
+// return false if the linked list does not contain it
+func (l *LinkedList[T]) RemoveValue(item T) bool {
+	return l.removeFunc(nil, l.size, func(value T) bool { return l.equals(value, item) }, 1) == 1
+}
+
+// RemoveAll removes every item equal to the item in one pass and returns
+// the number of items removed
+func (l *LinkedList[T]) RemoveAll(item T) int {
+	return l.removeFunc(nil, l.size, func(value T) bool { return l.equals(value, item) }, -1)
+}
+
+// RemoveIf removes every item for which f is true in one pass and returns
+// the number of items removed
+func (l *LinkedList[T]) RemoveIf(f func(T) bool) int {
+	return l.removeFunc(nil, l.size, f, -1)
+}
+
+// RetainIf removes every item for which f is false in one pass and returns
+// the number of items removed
+func (l *LinkedList[T]) RetainIf(f func(T) bool) int {
+	return l.removeFunc(nil, l.size, func(value T) bool { return !f(value) }, -1)
+}
+
+// removeFunc removes up to limit nodes, all of them if limit is negative,
+// for which f is true among the count nodes after prev, from the first one
+// if prev is nil, and returns the number of nodes removed
+func (l *LinkedList[T]) removeFunc(prev *Node[T], count int, f func(T) bool, limit int) int {
+	node := l.head
+	if prev != nil {
+		node = prev.next
+	}
+	removed := 0
+	for i := 0; i < count && removed != limit; i++ {
+		next := node.next
+		if f(node.value) {
+			l.unlinkAfter(prev, node)
+			node.owner = nil
+			removed++
+		} else {
+			prev = node
+		}
+		node = next
+	}
+	return removed
+}
+
+// indexFunc returns the index of the first of the count nodes from first
+// for which f is true
+// return -1 if there is none
+func indexFunc[T any](first *Node[T], count int, f func(T) bool) int {
+	for i, node := 0, first; i < count; i, node = i+1, node.next {
+		if f(node.value) {
+			return i
+		}
+	}
+	return -1
+}
+
+// lastIndexFunc returns the index of the last of the count nodes from first
+// for which f is true
+// return -1 if there is none
+func lastIndexFunc[T any](first *Node[T], count int, f func(T) bool) int {
+	index := -1
+	for i, node := 0, first; i < count; i, node = i+1, node.next {
+		if f(node.value) {
+			index = i
+		}
+	}
+	return index
+}
+
+// containsAll checks if the count nodes from first hold every item. Items
+// with a key are looked up in a set, others are compared with every item
+// still missing.
+func (l *LinkedList[T]) containsAll(first *Node[T], count int, items []T) bool {
+	if l.key == nil {
+		missing := slices.Clone(items)
+		for i, node := 0, first; i < count && len(missing) > 0; i, node = i+1, node.next {
+			missing = slices.DeleteFunc(missing, func(item T) bool { return l.equals(node.value, item) })
+		}
+		return len(missing) == 0
+	}
+	missing := make(map[any]struct{}, len(items))
+	for _, item := range items {
+		missing[l.key(item)] = struct{}{}
+	}
+	for i, node := 0, first; i < count && len(missing) > 0; i, node = i+1, node.next {
+		delete(missing, l.key(node.value))
+	}
+	return len(missing) == 0
+}
@@ -0,0 +1,110 @@
+package linkedlist
+
+// Concat moves the items of other to the end of the list in O(1) time,
+// leaving other empty. The elements of other become elements of the list.
+// return false if other is the list itself
+func (l *LinkedList[T]) Concat(other *LinkedList[T]) bool {
+	if other == l {
+		return false
+	}
+	l.spliceAfter(l.last, other)
+	return true
+}
+
+// Splice moves the items of other into the list at the specified index,
+// leaving other empty. Only finding the index takes time, moving the items
+// takes O(1). The elements of other become elements of the list.
+// return false if the index is out of range or other is the list itself
+func (l *LinkedList[T]) Splice(index int, other *LinkedList[T]) bool {
+	if index < 0 || index > l.size || other == l {
+		return false
+	}
+	var prev *Node[T]
+	if index == l.size {
+		prev = l.last
+	} else if index > 0 {
+		prev, _ = l.ElemAt(index - 1)
+	}
+	l.spliceAfter(prev, other)
+	return true
+}
+
+// spliceAfter links the nodes of other after prev, first if prev is nil
+func (l *LinkedList[T]) spliceAfter(prev *Node[T], other *LinkedList[T]) {
+	if other.size == 0 {
+		return
+	}
+	// The nodes of other now resolve to the owner of the list
+	other.owner.parent = l.root()
+	if prev == nil {
+		other.last.next = l.head
+		l.head = other.head
+	} else {
+		other.last.next = prev.next
+		prev.next = other.head
+	}
+	if other.last.next == nil {
+		l.last = other.last
+	}
+	l.size += other.size
+	l.modCount++
+	other.head, other.last, other.size, other.owner = nil, nil, 0, nil
+	other.modCount++
+}
+
+// SplitAt moves the items before the index to a first new list and the
+// others to a second one, leaving the list empty, in O(n) time. The elements
+// of the list become elements of the new lists.
+// return false if the index is out of range
+func (l *LinkedList[T]) SplitAt(index int) (*LinkedList[T], *LinkedList[T], bool) {
+	if index < 0 || index > l.size {
+		return nil, nil, false
+	}
+	back, _ := l.Sublist(index, l.size)
+	// The nodes left keep the owner of the list
+	front := l.empty()
+	front.head, front.last, front.size, front.owner = l.head, l.last, l.size, l.owner
+	l.head, l.last, l.size, l.owner = nil, nil, 0, nil
+	l.modCount++
+	return front, back, true
+}
+
+// Sublist moves the items from index from included to index to excluded
+// out of the list into a new list, in O(to) time. Their elements become
+// elements of the new list.
+// return false if the range is out of bounds
+func (l *LinkedList[T]) Sublist(from, to int) (*LinkedList[T], bool) {
+	if from < 0 || to > l.size || from > to {
+		return nil, false
+	}
+	sublist := l.empty()
+	if from == to {
+		return sublist, true
+	}
+
+	var prev *Node[T]
+	first := l.head
+	for i := 0; i < from; i++ {
+		prev, first = first, first.next
+	}
+	last := first
+	last.owner = sublist.root()
+	for i := from + 1; i < to; i++ {
+		last = last.next
+		last.owner = sublist.owner
+	}
+
+	if prev == nil {
+		l.head = last.next
+	} else {
+		prev.next = last.next
+	}
+	if last.next == nil {
+		l.last = prev
+	}
+	last.next = nil
+	l.size -= to - from
+	l.modCount++
+	sublist.head, sublist.last, sublist.size = first, last, to-from
+	return sublist, true
+}
@@ -0,0 +1,114 @@
+package linkedlist
+
+import (
+	"math/rand"
+	"slices"
+)
+
+// The transformations below relink the existing nodes like Sort, so the
+// handles of the elements stay valid, and take O(n) time.
+
+// Reverse reverses the order of the items by reversing the link of every node
+func (l *LinkedList[T]) Reverse() {
+	if l.size < 2 {
+		return
+	}
+	var prev *Node[T]
+	for node := l.head; node != nil; {
+		next := node.next
+		node.next = prev
+		prev, node = node, next
+	}
+	l.head, l.last = l.last, l.head
+	l.modCount++
+}
+
+// Rotate moves every item k places towards the end, the last k items
+// wrapping around to the beginning. A negative k rotates towards the
+// beginning.
+func (l *LinkedList[T]) Rotate(k int) {
+	if l.size < 2 {
+		return
+	}
+	k %= l.size
+	if k < 0 {
+		k += l.size
+	}
+	if k == 0 {
+		return
+	}
+	last, _ := l.ElemAt(l.size - k - 1)
+	l.last.next = l.head
+	l.head, l.last = last.next, last
+	l.last.next = nil
+	l.modCount++
+}
+
+// Shuffle puts the items in a random order drawn from src, in O(n) extra
+// space. Passing a source seeded with a fixed value, rand.NewSource(seed),
+// gives the same order on every run; a nil src uses the default source.
+func (l *LinkedList[T]) Shuffle(src rand.Source) {
+	if l.size < 2 {
+		return
+	}
+	intn := rand.Intn
+	if src != nil {
+		intn = rand.New(src).Intn
+	}
+	nodes := make([]*Node[T], 0, l.size)
+	for node := l.head; node != nil; node = node.next {
+		nodes = append(nodes, node)
+	}
+	// Fisher-Yates shuffle
+	for i := len(nodes) - 1; i > 0; i-- {
+		j := intn(i + 1)
+		nodes[i], nodes[j] = nodes[j], nodes[i]
+	}
+	for i := 1; i < len(nodes); i++ {
+		nodes[i-1].next = nodes[i]
+	}
+	l.head, l.last = nodes[0], nodes[len(nodes)-1]
+	l.last.next = nil
+	l.modCount++
+}
+
+// Dedupe removes the items equal to the item before them, keeping the first
+// of every run of equal items, and returns the number of items removed
+func (l *LinkedList[T]) Dedupe() int {
+	var last T
+	kept := false
+	return l.removeFunc(nil, l.size, func(item T) bool {
+		if kept && l.equals(item, last) {
+			return true
+		}
+		last, kept = item, true
+		return false
+	}, -1)
+}
+
+// DedupeAll removes the items equal to an item before them anywhere in the
+// list, keeping the first occurrence of every item, in O(n) extra space, and
+// returns the number of items removed. The items of a list created with
+// NewFunc have no hash, they are compared with every item kept, in O(n²)
+// time.
+func (l *LinkedList[T]) DedupeAll() int {
+	if l.key == nil {
+		var kept []T
+		return l.removeFunc(nil, l.size, func(item T) bool {
+			if slices.ContainsFunc(kept, func(keptItem T) bool { return l.equals(keptItem, item) }) {
+				return true
+			}
+			kept = append(kept, item)
+			return false
+		}, -1)
+	}
+	seen := make(map[any]struct{}, l.size)
+	return l.removeFunc(nil, l.size, func(item T) bool {
+		key := l.key(item)
+		if _, ok := seen[key]; ok {
+			return true
+		}
+		seen[key] = struct{}{}
+		return false
+	}, -1)
+}
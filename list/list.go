@@ -9,15 +9,29 @@
 // Reference: https://en.wikipedia.org/wiki/List_%28abstract_data_type%29
 package list
 
-import ()
+import "errors"
+
+// ErrConcurrentModification is the value a view panics with once the list it
+// was taken from was structurally modified other than through it
+var ErrConcurrentModification = errors.New("list: list modified outside of its view")
 
 // List interface that all lists implement
-type List[T comparable] interface {
+type List[T any] interface {
 	Append(items ...T)
 	Prepend(items ...T)
 	Get(index int) (T, bool)
 	Remove(index int) bool
 	Contains(item T) bool
+	ContainsAll(items ...T) bool
+	IndexOf(item T) int
+	LastIndexOf(item T) int
+	IndexFunc(f func(T) bool) int
+	RemoveValue(item T) bool
+	RemoveAll(item T) int
+	RemoveIf(f func(T) bool) int
+	RetainIf(f func(T) bool) int
+	Equal(other List[T]) bool
+	EqualFunc(other List[T], eq func(a, b T) bool) bool
 	GetAllNode() []T
 	GetSize() int
 	IsEmpty() bool
@@ -0,0 +1,292 @@
+package skiplist
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/TranThang-2804/golangds/list"
+)
+
+// cnode is an item of a concurrent skip list linked at len(next) levels.
+// Its value and links are read and written atomically, and a deleted node
+// keeps its links so that readers standing on it can carry on.
+type cnode[T any] struct {
+	value   atomic.Pointer[T]
+	next    []atomic.Pointer[cnode[T]]
+	deleted atomic.Bool
+}
+
+func newCnode[T any](item T, level int) *cnode[T] {
+	n := &cnode[T]{next: make([]atomic.Pointer[cnode[T]], level)}
+	n.value.Store(&item)
+	return n
+}
+
+// item returns the value of the node
+func (n *cnode[T]) item() T {
+	return *n.value.Load()
+}
+
+// Concurrent is a skip list safe for concurrent use whose readers never
+// block. Insert, Delete and Clear take a mutex, one at a time, while Search,
+// Contains, Range, Min, Max and the other reads only load the links
+// atomically. A read sees every item that is in the skip list during its
+// whole call, and may or may not see the items inserted, replaced or deleted
+// meanwhile. There are no rank queries, the numbers of nodes skipped by the
+// links could not be read consistently without the mutex.
+type Concurrent[T any] struct {
+	mutex      sync.Mutex
+	head       *cnode[T]
+	level      atomic.Int32
+	size       atomic.Int64
+	comparator list.Comparator[T]
+	levels     levels
+}
+
+// NewConcurrent creates a new empty concurrent skip list ordered by the
+// comparator, with the default options
+func NewConcurrent[T any](comparator list.Comparator[T]) *Concurrent[T] {
+	return NewConcurrentWith(comparator, Options{})
+}
+
+// NewConcurrentWith creates a new empty concurrent skip list ordered by the
+// comparator, with the options
+func NewConcurrentWith[T any](comparator list.Comparator[T], options Options) *Concurrent[T] {
+	options = options.withDefaults()
+	c := &Concurrent[T]{
+		head:       &cnode[T]{next: make([]atomic.Pointer[cnode[T]], options.MaxLevel)},
+		comparator: comparator,
+		levels:     newLevels(options),
+	}
+	c.level.Store(1)
+	return c
+}
+
+// Insert adds the item, or replaces the item comparing equal to it
+// return false if an item was replaced
+func (c *Concurrent[T]) Insert(item T) bool {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	update := c.predecessors(item)
+	if next := update[0].next[0].Load(); next != nil && c.comparator(next.item(), item) == 0 {
+		next.value.Store(&item)
+		return false
+	}
+
+	level := c.levels.next()
+	n := newCnode(item, level)
+	for i := 0; i < level; i++ {
+		n.next[i].Store(update[i].next[i].Load())
+	}
+	// The node is linked once its own links are set, from the bottom up
+	for i := 0; i < level; i++ {
+		update[i].next[i].Store(n)
+	}
+	if int32(level) > c.level.Load() {
+		c.level.Store(int32(level))
+	}
+	c.size.Add(1)
+	return true
+}
+
+// Delete removes the item comparing equal to the item
+// return false if the skip list does not contain it
+func (c *Concurrent[T]) Delete(item T) bool {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	update := c.predecessors(item)
+	x := update[0].next[0].Load()
+	if x == nil || c.comparator(x.item(), item) != 0 {
+		return false
+	}
+
+	// The node is marked first so that readers reaching it skip it, then
+	// unlinked from the top down
+	x.deleted.Store(true)
+	for i := len(x.next) - 1; i >= 0; i-- {
+		update[i].next[i].Store(x.next[i].Load())
+	}
+	for level := c.level.Load(); level > 1 && c.head.next[level-1].Load() == nil; level-- {
+		c.level.Store(level - 1)
+	}
+	c.size.Add(-1)
+	return true
+}
+
+// Search returns the item comparing equal to the item
+// return false if the skip list does not contain it
+func (c *Concurrent[T]) Search(item T) (T, bool) {
+	x := c.lowerBound(item)
+	if x == nil || c.comparator(x.item(), item) != 0 {
+		var t T
+		return t, false
+	}
+	return x.item(), true
+}
+
+// Check if the skip list contains an item comparing equal to the item
+func (c *Concurrent[T]) Contains(item T) bool {
+	_, ok := c.Search(item)
+	return ok
+}
+
+// Range calls f with the items from from included to to excluded, in order,
+// until f returns false
+func (c *Concurrent[T]) Range(from, to T, f func(item T) bool) {
+	for x := c.lowerBound(from); x != nil; x = c.nextLive(x) {
+		value := x.item()
+		if c.comparator(value, to) >= 0 || !f(value) {
+			return
+		}
+	}
+}
+
+// Min returns the lowest item
+// return false if the skip list is empty
+func (c *Concurrent[T]) Min() (T, bool) {
+	x := c.nextLive(c.head)
+	if x == nil {
+		var t T
+		return t, false
+	}
+	return x.item(), true
+}
+
+// Max returns the greatest item
+// return false if the skip list is empty
+func (c *Concurrent[T]) Max() (T, bool) {
+	for {
+		x := c.head
+		for i := int(c.level.Load()) - 1; i >= 0; i-- {
+			for next := x.next[i].Load(); next != nil; next = x.next[i].Load() {
+				x = next
+			}
+		}
+		if x == c.head {
+			var t T
+			return t, false
+		}
+		// A node deleted meanwhile may be the last one reached, look again
+		if !x.deleted.Load() {
+			return x.item(), true
+		}
+	}
+}
+
+// Clone returns a new concurrent skip list with the items, the levels of the
+// nodes and the options of the skip list, in O(n) time
+func (c *Concurrent[T]) Clone() *Concurrent[T] {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	clone := &Concurrent[T]{
+		head:       &cnode[T]{next: make([]atomic.Pointer[cnode[T]], len(c.head.next))},
+		comparator: c.comparator,
+		levels:     c.levels.clone(),
+	}
+	clone.level.Store(c.level.Load())
+	clone.size.Store(c.size.Load())
+	// last[i] is the last node of the clone linked at level i
+	last := make([]*cnode[T], len(c.head.next))
+	for i := range last {
+		last[i] = clone.head
+	}
+	for x := c.head.next[0].Load(); x != nil; x = x.next[0].Load() {
+		n := newCnode(x.item(), len(x.next))
+		for i := range n.next {
+			last[i].next[i].Store(n)
+			last[i] = n
+		}
+	}
+	return clone
+}
+
+// Return an array of all the items in order
+func (c *Concurrent[T]) GetAllNode() []T {
+	var items []T
+	for x := c.nextLive(c.head); x != nil; x = c.nextLive(x) {
+		items = append(items, x.item())
+	}
+	return items
+}
+
+// Get the number of items of the skip list
+func (c *Concurrent[T]) GetSize() int {
+	return int(c.size.Load())
+}
+
+// Check if the skip list is empty
+func (c *Concurrent[T]) IsEmpty() bool {
+	return c.GetSize() == 0
+}
+
+// Clear all the items of the skip list
+func (c *Concurrent[T]) Clear() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	for i := range c.head.next {
+		c.head.next[i].Store(nil)
+	}
+	c.level.Store(1)
+	c.size.Store(0)
+}
+
+// Return the string representation of the skip list
+func (c *Concurrent[T]) String() string {
+	str := "ConcurrentSkipList\n"
+	values := []string{}
+	for _, item := range c.GetAllNode() {
+		values = append(values, fmt.Sprintf("%v", item))
+	}
+	str += strings.Join(values, ", ")
+	return str
+}
+
+// predecessors returns the last node before the item at every level, the
+// head at the levels not in use. The mutex must be held.
+func (c *Concurrent[T]) predecessors(item T) []*cnode[T] {
+	update := make([]*cnode[T], len(c.head.next))
+	for i := range update {
+		update[i] = c.head
+	}
+	x := c.head
+	for i := int(c.level.Load()) - 1; i >= 0; i-- {
+		for next := x.next[i].Load(); next != nil && c.comparator(next.item(), item) < 0; next = x.next[i].Load() {
+			x = next
+		}
+		update[i] = x
+	}
+	return update
+}
+
+// lowerBound returns the first node not lower than the item and not deleted
+// return nil if there is none
+func (c *Concurrent[T]) lowerBound(item T) *cnode[T] {
+	x := c.head
+	for i := int(c.level.Load()) - 1; i >= 0; i-- {
+		for next := x.next[i].Load(); next != nil && c.comparator(next.item(), item) < 0; next = x.next[i].Load() {
+			x = next
+		}
+	}
+	// Nodes lower than the item may have been inserted after x meanwhile
+	next := x.next[0].Load()
+	for next != nil && (next.deleted.Load() || c.comparator(next.item(), item) < 0) {
+		next = next.next[0].Load()
+	}
+	return next
+}
+
+// nextLive returns the first node after x at level 0 that is not deleted
+// return nil if there is none
+func (c *Concurrent[T]) nextLive(x *cnode[T]) *cnode[T] {
+	next := x.next[0].Load()
+	for next != nil && next.deleted.Load() {
+		next = next.next[0].Load()
+	}
+	return next
+}
@@ -0,0 +1,148 @@
+package skiplist
+
+import (
+	"cmp"
+	"math/rand"
+	"slices"
+	"sync"
+	"testing"
+)
+
+func TestConcurrentSkipList(t *testing.T) {
+	c := NewConcurrentWith(cmp.Compare[int], Options{Source: rand.NewSource(1)})
+	items := rand.Perm(300)
+	for _, item := range items {
+		c.Insert(item)
+	}
+	if actualValue, expectedValue := c.Insert(7), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	for _, item := range items[:100] {
+		if actualValue, expectedValue := c.Delete(item), true; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+	if actualValue, expectedValue := c.Delete(items[0]), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	rest := slices.Clone(items[100:])
+	slices.Sort(rest)
+	if actualValue := c.GetAllNode(); !slices.Equal(actualValue, rest) {
+		t.Errorf("Got %v expected %v", actualValue, rest)
+	}
+	if actualValue, expectedValue := c.GetSize(), 200; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, ok := c.Search(rest[10]); actualValue != rest[10] || !ok {
+		t.Errorf("Got %v expected %v", actualValue, rest[10])
+	}
+	if _, ok := c.Search(items[0]); ok {
+		t.Errorf("Got %v expected %v", ok, false)
+	}
+	if actualValue, ok := c.Min(); actualValue != rest[0] || !ok {
+		t.Errorf("Got %v expected %v", actualValue, rest[0])
+	}
+	if actualValue, ok := c.Max(); actualValue != rest[len(rest)-1] || !ok {
+		t.Errorf("Got %v expected %v", actualValue, rest[len(rest)-1])
+	}
+
+	actualValue := []int{}
+	c.Range(rest[5], rest[8], func(item int) bool {
+		actualValue = append(actualValue, item)
+		return true
+	})
+	if expectedValue := rest[5:8]; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	clone := c.Clone()
+	c.Clear()
+	if actualValue, expectedValue := c.IsEmpty(), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if _, ok := c.Max(); ok {
+		t.Errorf("Got %v expected %v", ok, false)
+	}
+	if actualValue := clone.GetAllNode(); !slices.Equal(actualValue, rest) {
+		t.Errorf("Got %v expected %v", actualValue, rest)
+	}
+	c.Insert(2)
+	c.Insert(1)
+	if actualValue, expectedValue := c.String(), "ConcurrentSkipList\n1, 2"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestConcurrentSkipListReaders(t *testing.T) {
+	// The even items stay in the skip list while writers insert and delete
+	// odd ones, readers must always see every even item
+	c := NewConcurrent(cmp.Compare[int])
+	evens := []int{}
+	for i := 0; i < 200; i += 2 {
+		c.Insert(i)
+		evens = append(evens, i)
+	}
+
+	var writers, readers sync.WaitGroup
+	done := make(chan struct{})
+	for w := 0; w < 2; w++ {
+		writers.Add(1)
+		go func(seed int64) {
+			defer writers.Done()
+			random := rand.New(rand.NewSource(seed))
+			for i := 0; i < 2000; i++ {
+				item := random.Intn(100)*2 + 1
+				if random.Intn(2) == 0 {
+					c.Insert(item)
+				} else {
+					c.Delete(item)
+				}
+			}
+		}(int64(w))
+	}
+	for r := 0; r < 4; r++ {
+		readers.Add(1)
+		go func() {
+			defer readers.Done()
+			for {
+				select {
+				case <-done:
+					return
+				default:
+				}
+				for _, item := range evens {
+					if !c.Contains(item) {
+						t.Errorf("Got %v expected %v", false, true)
+						return
+					}
+				}
+				seen := []int{}
+				c.Range(0, 200, func(item int) bool {
+					if item%2 == 0 {
+						seen = append(seen, item)
+					}
+					return true
+				})
+				if !slices.Equal(seen, evens) {
+					t.Errorf("Got %v expected %v", seen, evens)
+					return
+				}
+				if actualValue, ok := c.Min(); actualValue != 0 || !ok {
+					t.Errorf("Got %v expected %v", actualValue, 0)
+					return
+				}
+			}
+		}()
+	}
+	writers.Wait()
+	close(done)
+	readers.Wait()
+
+	items := c.GetAllNode()
+	if !slices.IsSorted(items) {
+		t.Errorf("Got %v expected sorted items", items)
+	}
+	if actualValue, expectedValue := c.GetSize(), len(items); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
@@ -0,0 +1,347 @@
+// Package skiplist implements a skip list, an ordered collection of items.
+//
+// The items are kept sorted by a comparator in a linked list with express
+// lanes: every node is linked at a random number of levels, each level
+// skipping over the nodes linked only at the levels below it. A node linked at
+// some level is also linked at the next level up with a given probability, so
+// Insert, Delete, Search and the rank queries take O(log n) expected time.
+// Every link also records the number of nodes it skips, which gives the rank
+// of an item and the item at an index on the way down.
+//
+// Concurrent is a variant whose readers never block.
+//
+// Reference: https://en.wikipedia.org/wiki/Skip_list
+package skiplist
+
+import (
+	"fmt"
+	"math/rand"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/TranThang-2804/golangds/list"
+)
+
+// DefaultProbability is the probability that a node linked at a level is
+// also linked at the next one when Options.Probability is not set
+const DefaultProbability = 0.5
+
+// DefaultMaxLevel is the number of levels when Options.MaxLevel is not set,
+// enough for 2^32 items at the default probability
+const DefaultMaxLevel = 32
+
+// Options tunes a skip list
+type Options struct {
+	// Probability is the probability that a node linked at a level is also
+	// linked at the next one, DefaultProbability if not between 0 and 1
+	// excluded. A lower probability uses less links for longer searches.
+	Probability float64
+	// MaxLevel is the maximum number of levels, DefaultMaxLevel if not
+	// positive
+	MaxLevel int
+	// Source draws the levels of the nodes, a source seeded with the current
+	// time if nil. A source seeded with a fixed value, rand.NewSource(seed),
+	// builds the same skip list on every run.
+	Source rand.Source
+}
+
+func (o Options) withDefaults() Options {
+	if o.Probability <= 0 || o.Probability >= 1 {
+		o.Probability = DefaultProbability
+	}
+	if o.MaxLevel <= 0 {
+		o.MaxLevel = DefaultMaxLevel
+	}
+	if o.Source == nil {
+		o.Source = rand.NewSource(time.Now().UnixNano())
+	}
+	return o
+}
+
+// levels draws random levels for the nodes
+type levels struct {
+	random      *rand.Rand
+	probability float64
+	maxLevel    int
+}
+
+func newLevels(options Options) levels {
+	return levels{random: rand.New(options.Source), probability: options.Probability, maxLevel: options.MaxLevel}
+}
+
+// next returns the number of levels of a new node
+func (l levels) next() int {
+	level := 1
+	for level < l.maxLevel && l.random.Float64() < l.probability {
+		level++
+	}
+	return level
+}
+
+// clone returns levels drawn from a new source seeded by this one, so that
+// a skip list built with a fixed seed clones the same way on every run
+func (l levels) clone() levels {
+	l.random = rand.New(rand.NewSource(l.random.Int63()))
+	return l
+}
+
+// node is an item linked at len(next) levels
+type node[T any] struct {
+	value T
+	next  []*node[T]
+	// span[i] is the number of nodes next[i] moves forward by, up to the end
+	// of the list when next[i] is nil
+	span []int
+}
+
+// SkipList struct, head is a node without item linked at every level
+type SkipList[T any] struct {
+	head       *node[T]
+	level      int
+	size       int
+	comparator list.Comparator[T]
+	levels     levels
+}
+
+// New creates a new empty skip list ordered by the comparator, with the
+// default options
+func New[T any](comparator list.Comparator[T]) *SkipList[T] {
+	return NewWith(comparator, Options{})
+}
+
+// NewWith creates a new empty skip list ordered by the comparator, with the
+// options
+func NewWith[T any](comparator list.Comparator[T], options Options) *SkipList[T] {
+	options = options.withDefaults()
+	return &SkipList[T]{
+		head:       &node[T]{next: make([]*node[T], options.MaxLevel), span: make([]int, options.MaxLevel)},
+		level:      1,
+		comparator: comparator,
+		levels:     newLevels(options),
+	}
+}
+
+// Insert adds the item, or replaces the item comparing equal to it
+// return false if an item was replaced
+func (s *SkipList[T]) Insert(item T) bool {
+	// update[i] is the last node at level i before the item and rank[i] its
+	// index plus one, 0 for the head
+	update := make([]*node[T], len(s.head.next))
+	rank := make([]int, len(s.head.next))
+	x := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		if i < s.level-1 {
+			rank[i] = rank[i+1]
+		}
+		for x.next[i] != nil && s.comparator(x.next[i].value, item) < 0 {
+			rank[i] += x.span[i]
+			x = x.next[i]
+		}
+		update[i] = x
+	}
+	if next := x.next[0]; next != nil && s.comparator(next.value, item) == 0 {
+		next.value = item
+		return false
+	}
+
+	level := s.levels.next()
+	for i := s.level; i < level; i++ {
+		update[i] = s.head
+		s.head.span[i] = s.size
+	}
+	s.level = max(s.level, level)
+
+	n := &node[T]{value: item, next: make([]*node[T], level), span: make([]int, level)}
+	for i := 0; i < level; i++ {
+		n.next[i], update[i].next[i] = update[i].next[i], n
+		// The node splits the link of update[i] at index rank[0]
+		n.span[i] = update[i].span[i] - (rank[0] - rank[i])
+		update[i].span[i] = rank[0] - rank[i] + 1
+	}
+	for i := level; i < s.level; i++ {
+		update[i].span[i]++
+	}
+	s.size++
+	return true
+}
+
+// Delete removes the item comparing equal to the item
+// return false if the skip list does not contain it
+func (s *SkipList[T]) Delete(item T) bool {
+	update := make([]*node[T], s.level)
+	x := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		for x.next[i] != nil && s.comparator(x.next[i].value, item) < 0 {
+			x = x.next[i]
+		}
+		update[i] = x
+	}
+	x = x.next[0]
+	if x == nil || s.comparator(x.value, item) != 0 {
+		return false
+	}
+
+	for i := 0; i < s.level; i++ {
+		if update[i].next[i] == x {
+			update[i].next[i] = x.next[i]
+			update[i].span[i] += x.span[i] - 1
+		} else {
+			update[i].span[i]--
+		}
+	}
+	for s.level > 1 && s.head.next[s.level-1] == nil {
+		s.level--
+	}
+	s.size--
+	return true
+}
+
+// Search returns the item comparing equal to the item
+// return false if the skip list does not contain it
+func (s *SkipList[T]) Search(item T) (T, bool) {
+	x := s.lowerBound(item)
+	if x == nil || s.comparator(x.value, item) != 0 {
+		var t T
+		return t, false
+	}
+	return x.value, true
+}
+
+// Check if the skip list contains an item comparing equal to the item
+func (s *SkipList[T]) Contains(item T) bool {
+	_, ok := s.Search(item)
+	return ok
+}
+
+// Range calls f with the items from from included to to excluded, in order,
+// until f returns false
+func (s *SkipList[T]) Range(from, to T, f func(item T) bool) {
+	for x := s.lowerBound(from); x != nil && s.comparator(x.value, to) < 0; x = x.next[0] {
+		if !f(x.value) {
+			return
+		}
+	}
+}
+
+// Rank returns the number of items lower than the item, its index if the
+// skip list contains it
+func (s *SkipList[T]) Rank(item T) int {
+	rank := 0
+	x := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		for x.next[i] != nil && s.comparator(x.next[i].value, item) < 0 {
+			rank += x.span[i]
+			x = x.next[i]
+		}
+	}
+	return rank
+}
+
+// Get the item at the specified index
+// return false if the index is out of range
+func (s *SkipList[T]) Get(index int) (T, bool) {
+	if index < 0 || index >= s.size {
+		var t T
+		return t, false
+	}
+	// The head is at index -1, so the item is index+1 nodes forward
+	traversed := 0
+	x := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		for x.next[i] != nil && traversed+x.span[i] <= index+1 {
+			traversed += x.span[i]
+			x = x.next[i]
+		}
+		if traversed == index+1 {
+			break
+		}
+	}
+	return x.value, true
+}
+
+// Min returns the lowest item
+// return false if the skip list is empty
+func (s *SkipList[T]) Min() (T, bool) {
+	return s.Get(0)
+}
+
+// Max returns the greatest item
+// return false if the skip list is empty
+func (s *SkipList[T]) Max() (T, bool) {
+	return s.Get(s.size - 1)
+}
+
+// Clone returns a new skip list with the items, the levels of the nodes and
+// the options of the skip list, in O(n) time
+func (s *SkipList[T]) Clone() *SkipList[T] {
+	clone := &SkipList[T]{
+		head:       &node[T]{next: make([]*node[T], len(s.head.next)), span: slices.Clone(s.head.span)},
+		level:      s.level,
+		size:       s.size,
+		comparator: s.comparator,
+		levels:     s.levels.clone(),
+	}
+	// last[i] is the last node of the clone linked at level i
+	last := make([]*node[T], len(s.head.next))
+	for i := range last {
+		last[i] = clone.head
+	}
+	for x := s.head.next[0]; x != nil; x = x.next[0] {
+		n := &node[T]{value: x.value, next: make([]*node[T], len(x.next)), span: slices.Clone(x.span)}
+		for i := range n.next {
+			last[i].next[i], last[i] = n, n
+		}
+	}
+	return clone
+}
+
+// Return an array of all the items in order
+func (s *SkipList[T]) GetAllNode() []T {
+	items := make([]T, 0, s.size)
+	for x := s.head.next[0]; x != nil; x = x.next[0] {
+		items = append(items, x.value)
+	}
+	return items
+}
+
+// Get the number of items of the skip list
+func (s *SkipList[T]) GetSize() int {
+	return s.size
+}
+
+// Check if the skip list is empty
+func (s *SkipList[T]) IsEmpty() bool {
+	return s.size == 0
+}
+
+// Clear all the items of the skip list
+func (s *SkipList[T]) Clear() {
+	clear(s.head.next)
+	clear(s.head.span)
+	s.level = 1
+	s.size = 0
+}
+
+// Return the string representation of the skip list
+func (s *SkipList[T]) String() string {
+	str := "SkipList\n"
+	values := []string{}
+	for _, item := range s.GetAllNode() {
+		values = append(values, fmt.Sprintf("%v", item))
+	}
+	str += strings.Join(values, ", ")
+	return str
+}
+
+// lowerBound returns the first node not lower than the item
+// return nil if there is none
+func (s *SkipList[T]) lowerBound(item T) *node[T] {
+	x := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		for x.next[i] != nil && s.comparator(x.next[i].value, item) < 0 {
+			x = x.next[i]
+		}
+	}
+	return x.next[0]
+}
@@ -0,0 +1,329 @@
+package skiplist
+
+import (
+	"cmp"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+// entry is an item ordered by its key only, to tell apart equal items
+type entry struct {
+	key   int
+	label string
+}
+
+func compareEntries(a, b entry) int {
+	return cmp.Compare(a.key, b.key)
+}
+
+// checkSpans checks the size and the number of nodes skipped by every link
+func checkSpans[T any](t *testing.T, s *SkipList[T]) {
+	t.Helper()
+	index := map[*node[T]]int{s.head: -1}
+	size := 0
+	for x := s.head.next[0]; x != nil; x = x.next[0] {
+		index[x] = size
+		size++
+	}
+	if actualValue, expectedValue := s.GetSize(), size; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	for x, at := range index {
+		for i, next := range x.next {
+			if x == s.head && i >= s.level {
+				break
+			}
+			expectedValue := size - 1 - at
+			if next != nil {
+				expectedValue = index[next] - at
+			}
+			if actualValue := x.span[i]; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		}
+	}
+}
+
+func TestSkipListInsert(t *testing.T) {
+	s := NewWith(cmp.Compare[int], Options{Source: rand.NewSource(1)})
+	items := rand.Perm(1000)
+	for _, item := range items {
+		if actualValue, expectedValue := s.Insert(item), true; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+	slices.Sort(items)
+	if actualValue := s.GetAllNode(); !slices.Equal(actualValue, items) {
+		t.Errorf("Got %v expected %v", actualValue, items)
+	}
+	checkSpans(t, s)
+
+	// Equal items are replaced
+	entries := New(compareEntries)
+	entries.Insert(entry{1, "a"})
+	if actualValue, expectedValue := entries.Insert(entry{1, "b"}), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, ok := entries.Search(entry{key: 1}); actualValue.label != "b" || !ok {
+		t.Errorf("Got %v expected %v", actualValue, entry{1, "b"})
+	}
+	if actualValue, expectedValue := entries.GetSize(), 1; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestSkipListDelete(t *testing.T) {
+	s := NewWith(cmp.Compare[int], Options{Source: rand.NewSource(2)})
+	items := rand.Perm(500)
+	for _, item := range items {
+		s.Insert(item)
+	}
+	for _, item := range items[:400] {
+		if actualValue, expectedValue := s.Delete(item), true; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+	if actualValue, expectedValue := s.Delete(items[0]), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := s.Delete(1000), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	rest := slices.Clone(items[400:])
+	slices.Sort(rest)
+	if actualValue := s.GetAllNode(); !slices.Equal(actualValue, rest) {
+		t.Errorf("Got %v expected %v", actualValue, rest)
+	}
+	checkSpans(t, s)
+
+	for _, item := range rest {
+		s.Delete(item)
+	}
+	if actualValue, expectedValue := s.IsEmpty(), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := s.level, 1; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	s.Insert(3)
+	checkSpans(t, s)
+}
+
+func TestSkipListSearch(t *testing.T) {
+	s := New(compareEntries)
+	s.Insert(entry{3, "c"})
+	s.Insert(entry{1, "a"})
+	s.Insert(entry{2, "b"})
+	if actualValue, ok := s.Search(entry{key: 2}); actualValue != (entry{2, "b"}) || !ok {
+		t.Errorf("Got %v expected %v", actualValue, entry{2, "b"})
+	}
+	if actualValue, ok := s.Search(entry{key: 4}); actualValue != (entry{}) || ok {
+		t.Errorf("Got %v expected %v", actualValue, entry{})
+	}
+	if actualValue, expectedValue := s.Contains(entry{key: 1}), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := s.Contains(entry{key: 0}), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestSkipListRange(t *testing.T) {
+	s := New(cmp.Compare[int])
+	for _, item := range []int{5, 1, 3, 2, 4} {
+		s.Insert(item)
+	}
+	actualValue := []int{}
+	s.Range(2, 5, func(item int) bool {
+		actualValue = append(actualValue, item)
+		return true
+	})
+	if expectedValue := []int{2, 3, 4}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// f stops the iteration
+	actualValue = []int{}
+	s.Range(0, 10, func(item int) bool {
+		actualValue = append(actualValue, item)
+		return item < 2
+	})
+	if expectedValue := []int{1, 2}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestSkipListRank(t *testing.T) {
+	s := NewWith(cmp.Compare[int], Options{Source: rand.NewSource(3)})
+	for _, item := range rand.Perm(200) {
+		s.Insert(item * 2)
+	}
+	for i := 0; i < 200; i++ {
+		if actualValue, expectedValue := s.Rank(i*2), i; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := s.Rank(i*2+1), i+1; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if actualValue, ok := s.Get(i); actualValue != i*2 || !ok {
+			t.Errorf("Got %v expected %v", actualValue, i*2)
+		}
+	}
+	if actualValue, expectedValue := s.Rank(-1), 0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if _, ok := s.Get(200); ok {
+		t.Errorf("Got %v expected %v", ok, false)
+	}
+	if _, ok := s.Get(-1); ok {
+		t.Errorf("Got %v expected %v", ok, false)
+	}
+}
+
+func TestSkipListMinMax(t *testing.T) {
+	s := New(cmp.Compare[int])
+	if _, ok := s.Min(); ok {
+		t.Errorf("Got %v expected %v", ok, false)
+	}
+	if _, ok := s.Max(); ok {
+		t.Errorf("Got %v expected %v", ok, false)
+	}
+	for _, item := range []int{3, 1, 4, 1, 5} {
+		s.Insert(item)
+	}
+	if actualValue, ok := s.Min(); actualValue != 1 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	if actualValue, ok := s.Max(); actualValue != 5 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 5)
+	}
+}
+
+// heights returns the number of levels of every node
+func heights[T any](s *SkipList[T]) []int {
+	var heights []int
+	for x := s.head.next[0]; x != nil; x = x.next[0] {
+		heights = append(heights, len(x.next))
+	}
+	return heights
+}
+
+func TestSkipListOptions(t *testing.T) {
+	// The same seed builds the same skip list
+	a := NewWith(cmp.Compare[int], Options{Source: rand.NewSource(42)})
+	b := NewWith(cmp.Compare[int], Options{Source: rand.NewSource(42)})
+	for i := 0; i < 100; i++ {
+		a.Insert(i)
+		b.Insert(i)
+	}
+	if actualValue, expectedValue := heights(a), heights(b); !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	s := NewWith(cmp.Compare[int], Options{MaxLevel: 3, Probability: 0.9, Source: rand.NewSource(1)})
+	for i := 0; i < 100; i++ {
+		s.Insert(i)
+	}
+	if actualValue, expectedValue := slices.Max(heights(s)), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	checkSpans(t, s)
+
+	s = NewWith(cmp.Compare[int], Options{MaxLevel: 1})
+	for i := 0; i < 100; i++ {
+		s.Insert(i)
+	}
+	if actualValue, expectedValue := slices.Max(heights(s)), 1; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := s.Rank(50), 50; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestSkipListClone(t *testing.T) {
+	s := NewWith(cmp.Compare[int], Options{Source: rand.NewSource(4)})
+	for _, item := range rand.Perm(100) {
+		s.Insert(item)
+	}
+	clone := s.Clone()
+	if actualValue, expectedValue := heights(clone), heights(s); !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	checkSpans(t, clone)
+
+	clone.Delete(0)
+	clone.Insert(100)
+	s.Delete(50)
+	if actualValue, expectedValue := clone.GetSize(), 100; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := clone.Contains(50), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := s.Contains(0), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	checkSpans(t, clone)
+	checkSpans(t, s)
+}
+
+func TestSkipListClear(t *testing.T) {
+	s := New(cmp.Compare[int])
+	for i := 0; i < 50; i++ {
+		s.Insert(i)
+	}
+	s.Clear()
+	if actualValue, expectedValue := s.IsEmpty(), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := s.Contains(1), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	s.Insert(2)
+	s.Insert(1)
+	if actualValue, expectedValue := s.String(), "SkipList\n1, 2"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	checkSpans(t, s)
+}
+
+func benchmarkInsert(b *testing.B, size int) {
+	items := rand.Perm(size)
+	for i := 0; i < b.N; i++ {
+		s := New(cmp.Compare[int])
+		for _, item := range items {
+			s.Insert(item)
+		}
+	}
+}
+
+func benchmarkSearch(b *testing.B, size int) {
+	s := New(cmp.Compare[int])
+	for _, item := range rand.Perm(size) {
+		s.Insert(item)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			s.Search(n)
+		}
+	}
+}
+
+func BenchmarkSkipListInsert1000(b *testing.B) {
+	benchmarkInsert(b, 1000)
+}
+
+func BenchmarkSkipListInsert100000(b *testing.B) {
+	benchmarkInsert(b, 100000)
+}
+
+func BenchmarkSkipListSearch1000(b *testing.B) {
+	benchmarkSearch(b, 1000)
+}
+
+func BenchmarkSkipListSearch100000(b *testing.B) {
+	benchmarkSearch(b, 100000)
+}
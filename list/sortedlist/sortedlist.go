@@ -0,0 +1,256 @@
+// Package sortedlist implements a linked list that keeps its items sorted.
+//
+// The items are ordered by a comparator, the lowest first, and every item is
+// inserted at its position when added, so the list never needs sorting.
+// Items comparing equal are either all kept in the order they were added,
+// rejected or replaced by the newest one, following the duplicate policy of
+// the list. Adding an item takes O(n) time, O(1) when items are added in
+// order, and merging two sorted lists takes O(n+m).
+//
+// Reference: https://en.wikipedia.org/wiki/Sorted_array
+package sortedlist
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/TranThang-2804/golangds/list"
+	"github.com/TranThang-2804/golangds/list/doublelinkedlist"
+)
+
+// Duplicates is the policy of a sorted list for an item comparing equal to
+// one already in it
+type Duplicates int
+
+const (
+	// AllowDuplicates keeps every item, the equal ones in the order they
+	// were added
+	AllowDuplicates Duplicates = iota
+	// RejectDuplicates keeps the item already in the list
+	RejectDuplicates
+	// ReplaceDuplicates replaces the item already in the list with the new one
+	ReplaceDuplicates
+)
+
+// SortedList struct
+type SortedList[T any] struct {
+	list       *doublelinkedlist.DoubleLinkedList[T]
+	comparator list.Comparator[T]
+	duplicates Duplicates
+}
+
+// New creates a new empty sorted list ordered by the comparator, keeping
+// duplicates
+func New[T any](comparator list.Comparator[T]) *SortedList[T] {
+	return NewWithDuplicates(comparator, AllowDuplicates)
+}
+
+// NewWithDuplicates creates a new empty sorted list ordered by the
+// comparator, handling duplicates with the policy
+func NewWithDuplicates[T any](comparator list.Comparator[T], duplicates Duplicates) *SortedList[T] {
+	return &SortedList[T]{
+		list:       doublelinkedlist.NewFunc[T](nil),
+		comparator: comparator,
+		duplicates: duplicates,
+	}
+}
+
+// Add inserts the items at their positions and returns the number of items
+// added or replacing an equal one, rejected duplicates are not counted
+func (l *SortedList[T]) Add(items ...T) int {
+	added := 0
+	for _, item := range items {
+		if l.add(item) {
+			added++
+		}
+	}
+	return added
+}
+
+// add inserts the item after the items not greater than it, walking from the
+// end so that adding items in order takes O(1) time
+// return false if the item is rejected as a duplicate
+func (l *SortedList[T]) add(item T) bool {
+	node := l.list.Back()
+	for node != nil && l.comparator(node.Value(), item) > 0 {
+		node = node.Prev()
+	}
+	if node != nil && l.duplicates != AllowDuplicates && l.comparator(node.Value(), item) == 0 {
+		if l.duplicates == RejectDuplicates {
+			return false
+		}
+		node.SetValue(item)
+		return true
+	}
+	if node == nil {
+		l.list.PushFrontElem(item)
+	} else {
+		l.list.InsertAfterElem(item, node)
+	}
+	return true
+}
+
+// Get the item at the specified index
+// return false if the index is out of range
+func (l *SortedList[T]) Get(index int) (T, bool) {
+	return l.list.Get(index)
+}
+
+// Remove the item at the specified index
+// return false if the index is out of range
+func (l *SortedList[T]) Remove(index int) bool {
+	return l.list.Remove(index)
+}
+
+// RemoveValue removes the first item comparing equal to the item
+// return false if the list does not contain it
+func (l *SortedList[T]) RemoveValue(item T) bool {
+	node := l.lowerBound(item)
+	if node == nil || l.comparator(node.Value(), item) != 0 {
+		return false
+	}
+	return l.list.RemoveElem(node)
+}
+
+// Check if the list contains an item comparing equal to the item
+func (l *SortedList[T]) Contains(item T) bool {
+	node := l.lowerBound(item)
+	return node != nil && l.comparator(node.Value(), item) == 0
+}
+
+// Min returns the lowest item
+// return false if the list is empty
+func (l *SortedList[T]) Min() (T, bool) {
+	return value(l.list.Front())
+}
+
+// Max returns the greatest item
+// return false if the list is empty
+func (l *SortedList[T]) Max() (T, bool) {
+	return value(l.list.Back())
+}
+
+// Floor returns the greatest item not greater than the item, the last added
+// among equal ones
+// return false if there is none
+func (l *SortedList[T]) Floor(item T) (T, bool) {
+	node := l.list.Back()
+	for node != nil && l.comparator(node.Value(), item) > 0 {
+		node = node.Prev()
+	}
+	return value(node)
+}
+
+// Ceiling returns the lowest item not lower than the item, the first added
+// among equal ones
+// return false if there is none
+func (l *SortedList[T]) Ceiling(item T) (T, bool) {
+	return value(l.lowerBound(item))
+}
+
+// Range calls f with the items from from included to to excluded, in order,
+// until f returns false
+func (l *SortedList[T]) Range(from, to T, f func(item T) bool) {
+	for node := l.lowerBound(from); node != nil && l.comparator(node.Value(), to) < 0; node = node.Next() {
+		if !f(node.Value()) {
+			return
+		}
+	}
+}
+
+// Merge moves the items of other, which must be ordered like the list, into
+// the list in O(n+m) time, leaving other empty. Items of the list come before
+// equal items of other, and duplicates are handled with the policy of the
+// list.
+// return false if other is the list itself
+func (l *SortedList[T]) Merge(other *SortedList[T]) bool {
+	if other == l {
+		return false
+	}
+	boundary := l.list.Back()
+	l.list.Concat(other.list)
+	b := l.list.Front()
+	if boundary != nil {
+		b = boundary.Next()
+	}
+
+	// The items of the list already merged come first, then those of the list
+	// left from a, then those of other left from b
+	a := l.list.Front()
+	for b != nil {
+		next := b.Next()
+		for a != b && l.comparator(a.Value(), b.Value()) <= 0 {
+			a = a.Next()
+		}
+		if prev := a.Prev(); l.duplicates != AllowDuplicates && prev != nil && l.comparator(prev.Value(), b.Value()) == 0 {
+			if l.duplicates == ReplaceDuplicates {
+				prev.SetValue(b.Value())
+			}
+			l.list.RemoveElem(b)
+		} else if a != b {
+			l.list.MoveBefore(b, a)
+		}
+		if a == b {
+			a = next
+		}
+		b = next
+	}
+	return true
+}
+
+// Clone returns a new sorted list with the items, the comparator and the
+// duplicate policy of the list
+func (l *SortedList[T]) Clone() *SortedList[T] {
+	return &SortedList[T]{list: l.list.Clone(), comparator: l.comparator, duplicates: l.duplicates}
+}
+
+// Return an array of all the items in order
+func (l *SortedList[T]) GetAllNode() []T {
+	return l.list.GetAllNode()
+}
+
+// Get the number of items of the list
+func (l *SortedList[T]) GetSize() int {
+	return l.list.GetSize()
+}
+
+// Check if the list is empty
+func (l *SortedList[T]) IsEmpty() bool {
+	return l.list.IsEmpty()
+}
+
+// Clear all the items of the list
+func (l *SortedList[T]) Clear() {
+	l.list.Clear()
+}
+
+// Return the string representation of the list
+func (l *SortedList[T]) String() string {
+	str := "SortedList\n"
+	values := []string{}
+	for _, item := range l.GetAllNode() {
+		values = append(values, fmt.Sprintf("%v", item))
+	}
+	str += strings.Join(values, ", ")
+	return str
+}
+
+// lowerBound returns the first node not lower than the item
+// return nil if there is none
+func (l *SortedList[T]) lowerBound(item T) *doublelinkedlist.Node[T] {
+	node := l.list.Front()
+	for node != nil && l.comparator(node.Value(), item) < 0 {
+		node = node.Next()
+	}
+	return node
+}
+
+// value returns the value of the node
+// return false if the node is nil
+func value[T any](node *doublelinkedlist.Node[T]) (T, bool) {
+	if node == nil {
+		var t T
+		return t, false
+	}
+	return node.Value(), true
+}
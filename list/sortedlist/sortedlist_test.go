@@ -0,0 +1,237 @@
+package sortedlist
+
+import (
+	"cmp"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+// entry is an item ordered by its key only, to tell apart equal items
+type entry struct {
+	key   int
+	label string
+}
+
+func compareEntries(a, b entry) int {
+	return cmp.Compare(a.key, b.key)
+}
+
+func TestSortedListAdd(t *testing.T) {
+	list := New(cmp.Compare[int])
+	if actualValue, expectedValue := list.Add(5, 1, 4, 1, 3), 5; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := list.GetAllNode(), []int{1, 1, 3, 4, 5}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	list.Add(0, 6, 2)
+	if actualValue, expectedValue := list.GetAllNode(), []int{0, 1, 1, 2, 3, 4, 5, 6}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, ok := list.Get(3); actualValue != 2 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+	if actualValue, expectedValue := list.GetSize(), 8; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	items := rand.Perm(100)
+	list.Clear()
+	list.Add(items...)
+	slices.Sort(items)
+	if actualValue := list.GetAllNode(); !slices.Equal(actualValue, items) {
+		t.Errorf("Got %v expected %v", actualValue, items)
+	}
+}
+
+func TestSortedListDuplicates(t *testing.T) {
+	for _, test := range []struct {
+		duplicates    Duplicates
+		added         int
+		expectedValue []entry
+	}{
+		{AllowDuplicates, 4, []entry{{1, "b"}, {2, "a"}, {2, "c"}, {2, "d"}}},
+		{RejectDuplicates, 2, []entry{{1, "b"}, {2, "a"}}},
+		{ReplaceDuplicates, 4, []entry{{1, "b"}, {2, "d"}}},
+	} {
+		list := NewWithDuplicates(compareEntries, test.duplicates)
+		if actualValue := list.Add(entry{2, "a"}, entry{1, "b"}, entry{2, "c"}, entry{2, "d"}); actualValue != test.added {
+			t.Errorf("Got %v expected %v", actualValue, test.added)
+		}
+		if actualValue := list.GetAllNode(); !slices.Equal(actualValue, test.expectedValue) {
+			t.Errorf("Got %v expected %v", actualValue, test.expectedValue)
+		}
+	}
+}
+
+func TestSortedListFloorCeiling(t *testing.T) {
+	list := New(compareEntries)
+	list.Add(entry{1, "a"}, entry{3, "b"}, entry{3, "c"}, entry{5, "d"})
+
+	for _, test := range []struct {
+		key                  int
+		floor, ceiling       entry
+		hasFloor, hasCeiling bool
+	}{
+		{0, entry{}, entry{1, "a"}, false, true},
+		{1, entry{1, "a"}, entry{1, "a"}, true, true},
+		{2, entry{1, "a"}, entry{3, "b"}, true, true},
+		{3, entry{3, "c"}, entry{3, "b"}, true, true},
+		{4, entry{3, "c"}, entry{5, "d"}, true, true},
+		{6, entry{5, "d"}, entry{}, true, false},
+	} {
+		if actualValue, ok := list.Floor(entry{key: test.key}); actualValue != test.floor || ok != test.hasFloor {
+			t.Errorf("Floor(%d): Got %v expected %v", test.key, actualValue, test.floor)
+		}
+		if actualValue, ok := list.Ceiling(entry{key: test.key}); actualValue != test.ceiling || ok != test.hasCeiling {
+			t.Errorf("Ceiling(%d): Got %v expected %v", test.key, actualValue, test.ceiling)
+		}
+	}
+}
+
+func TestSortedListRemoveValue(t *testing.T) {
+	list := New(cmp.Compare[int])
+	list.Add(1, 2, 2, 3)
+	if actualValue, expectedValue := list.Contains(2), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := list.RemoveValue(2), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := list.RemoveValue(4), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := list.RemoveValue(0), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := list.GetAllNode(), []int{1, 2, 3}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	list.Remove(0)
+	if actualValue, expectedValue := list.Contains(1), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestSortedListMinMax(t *testing.T) {
+	list := New(cmp.Compare[int])
+	if _, ok := list.Min(); ok {
+		t.Errorf("Got %v expected %v", ok, false)
+	}
+	if _, ok := list.Max(); ok {
+		t.Errorf("Got %v expected %v", ok, false)
+	}
+	list.Add(3, 1, 2)
+	if actualValue, ok := list.Min(); actualValue != 1 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	if actualValue, ok := list.Max(); actualValue != 3 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+}
+
+func TestSortedListRange(t *testing.T) {
+	list := New(cmp.Compare[int])
+	list.Add(1, 2, 3, 3, 4, 5)
+
+	actualValue := []int{}
+	list.Range(2, 5, func(item int) bool {
+		actualValue = append(actualValue, item)
+		return true
+	})
+	if expectedValue := []int{2, 3, 3, 4}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// f stops the iteration
+	actualValue = []int{}
+	list.Range(0, 10, func(item int) bool {
+		actualValue = append(actualValue, item)
+		return item < 3
+	})
+	if expectedValue := []int{1, 2, 3}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	list.Range(4, 4, func(item int) bool {
+		t.Errorf("Got %v expected no item", item)
+		return true
+	})
+}
+
+func TestSortedListMerge(t *testing.T) {
+	for _, test := range []struct {
+		duplicates    Duplicates
+		expectedValue []entry
+	}{
+		{AllowDuplicates, []entry{{0, "x"}, {1, "a"}, {1, "y"}, {2, "z"}, {2, "w"}, {3, "b"}, {5, "c"}, {6, "v"}}},
+		{RejectDuplicates, []entry{{0, "x"}, {1, "a"}, {2, "z"}, {3, "b"}, {5, "c"}, {6, "v"}}},
+		{ReplaceDuplicates, []entry{{0, "x"}, {1, "y"}, {2, "w"}, {3, "b"}, {5, "c"}, {6, "v"}}},
+	} {
+		list := NewWithDuplicates(compareEntries, test.duplicates)
+		list.Add(entry{1, "a"}, entry{3, "b"}, entry{5, "c"})
+		other := New(compareEntries)
+		other.Add(entry{0, "x"}, entry{1, "y"}, entry{2, "z"}, entry{2, "w"}, entry{6, "v"})
+
+		if actualValue, expectedValue := list.Merge(other), true; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if actualValue := list.GetAllNode(); !slices.Equal(actualValue, test.expectedValue) {
+			t.Errorf("Got %v expected %v", actualValue, test.expectedValue)
+		}
+		if actualValue, expectedValue := other.IsEmpty(), true; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+
+	// Merging into an empty list, an empty list and the list itself
+	list, other := New(cmp.Compare[int]), New(cmp.Compare[int])
+	other.Add(2, 1)
+	list.Merge(other)
+	list.Merge(New(cmp.Compare[int]))
+	if actualValue, expectedValue := list.Merge(list), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := list.GetAllNode(), []int{1, 2}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// Random lists
+	a, b := rand.Perm(50), rand.Perm(30)
+	list, other = New(cmp.Compare[int]), New(cmp.Compare[int])
+	list.Add(a...)
+	other.Add(b...)
+	list.Merge(other)
+	expectedValue := append(a, b...)
+	slices.Sort(expectedValue)
+	if actualValue := list.GetAllNode(); !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	list.Add(25)
+	if actualValue, expectedValue := list.GetSize(), 81; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestSortedListClone(t *testing.T) {
+	list := NewWithDuplicates(cmp.Compare[int], RejectDuplicates)
+	list.Add(2, 1)
+	clone := list.Clone()
+	clone.Add(3, 1)
+	list.RemoveValue(2)
+	if actualValue, expectedValue := list.GetAllNode(), []int{1}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := clone.GetAllNode(), []int{1, 2, 3}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestSortedListString(t *testing.T) {
+	list := New(cmp.Compare[int])
+	list.Add(2, 1, 3)
+	if actualValue, expectedValue := list.String(), "SortedList\n1, 2, 3"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
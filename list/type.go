@@ -1,3 +1,3 @@
 package list
 
-type Comparator[T comparable] func(a, b T) int
+type Comparator[T any] func(a, b T) int
@@ -8,13 +8,14 @@ import (
 )
 
 // LinkedListQueue is a struct to represent a linked list queue
-type LinkedListQueue[T comparable] struct {
+type LinkedListQueue[T any] struct {
 	linkedList *linkedlist.LinkedList[T]
 }
 
-// New creates a new empty linked list queue
-func New[T comparable]() *LinkedListQueue[T] {
-	return &LinkedListQueue[T]{linkedList: linkedlist.New[T]()}
+// New creates a new empty linked list queue, of values of any type as the
+// queue never compares them
+func New[T any]() *LinkedListQueue[T] {
+	return &LinkedListQueue[T]{linkedList: linkedlist.NewFunc[T](nil)}
 }
 
 // Enqueue adds a value to the end of the queue
@@ -61,6 +62,11 @@ func (q *LinkedListQueue[T]) IsEmpty() bool {
   return q.linkedList.IsEmpty()
 }
 
+// Clone returns a new queue with the elements of the queue
+func (q *LinkedListQueue[T]) Clone() *LinkedListQueue[T] {
+	return &LinkedListQueue[T]{linkedList: q.linkedList.Clone()}
+}
+
 // Return the string representation of the stack
 func (s *LinkedListQueue[T]) String() string {
 	str := "LinkedListQueue\n"
@@ -2,6 +2,7 @@ package linkedlistqueue
 
 import (
 	// "encoding/json"
+	"slices"
 	"strings"
 	"testing"
 )
@@ -249,6 +250,33 @@ func TestQueueString(t *testing.T) {
 	}
 }
 
+func TestQueueClone(t *testing.T) {
+	queue := New[int]()
+	queue.Enqueue(1)
+	queue.Enqueue(2)
+	clone := queue.Clone()
+	clone.Enqueue(3)
+	queue.Dequeue()
+	if actualValue, expectedValue := queue.Values(), []int{2}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := clone.Values(), []int{1, 2, 3}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestQueueAnyType(t *testing.T) {
+	queue := New[[]int]()
+	queue.Enqueue([]int{1, 2})
+	queue.Enqueue(nil)
+	if actualValue, ok := queue.Dequeue(); !slices.Equal(actualValue, []int{1, 2}) || !ok {
+		t.Errorf("Got %v expected %v", actualValue, []int{1, 2})
+	}
+	if actualValue, ok := queue.Peek(); actualValue != nil || !ok {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+}
+
 func benchmarkEnqueue(b *testing.B, queue *LinkedListQueue[int], size int) {
 	for i := 0; i < b.N; i++ {
 		for n := 0; n < size; n++ {
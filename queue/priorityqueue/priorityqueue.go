@@ -0,0 +1,132 @@
+// Package priorityqueue implements a priority queue backed by a binary heap.
+//
+// The element that compares lowest with the comparator is always at the front
+// of the queue, so a comparator such as cmp.Compare gives a min-queue.
+// Enqueue and Dequeue run in O(log n), Peek in O(1).
+//
+// Reference: https://en.wikipedia.org/wiki/Priority_queue
+package priorityqueue
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/TranThang-2804/golangds/list"
+)
+
+// PriorityQueue is a struct to represent a heap based priority queue
+type PriorityQueue[T any] struct {
+	heap       []T
+	comparator list.Comparator[T]
+}
+
+// New creates a new empty priority queue ordered by the comparator
+func New[T any](comparator list.Comparator[T]) *PriorityQueue[T] {
+	return &PriorityQueue[T]{comparator: comparator}
+}
+
+// Enqueue adds a value to the queue
+func (q *PriorityQueue[T]) Enqueue(value T) {
+	q.heap = append(q.heap, value)
+	q.siftUp(len(q.heap) - 1)
+}
+
+// Dequeue removes the element with the highest priority from the queue
+func (q *PriorityQueue[T]) Dequeue() (T, bool) {
+	if len(q.heap) == 0 {
+		var zeroValue T
+		return zeroValue, false
+	}
+
+	value := q.heap[0]
+	last := len(q.heap) - 1
+	q.heap[0] = q.heap[last]
+	var zeroValue T
+	q.heap[last] = zeroValue
+	q.heap = q.heap[:last]
+	if last > 0 {
+		q.siftDown(0)
+	}
+	return value, true
+}
+
+// Peek returns the element with the highest priority without removing it
+func (q *PriorityQueue[T]) Peek() (T, bool) {
+	if len(q.heap) == 0 {
+		var zeroValue T
+		return zeroValue, false
+	}
+	return q.heap[0], true
+}
+
+// Values returns all the elements of the queue in heap order
+func (q *PriorityQueue[T]) Values() []T {
+	values := make([]T, len(q.heap))
+	copy(values, q.heap)
+	return values
+}
+
+// Size returns the number of elements
+func (q *PriorityQueue[T]) Size() int {
+	return len(q.heap)
+}
+
+// IsEmpty returns true if the queue is empty
+func (q *PriorityQueue[T]) IsEmpty() bool {
+	return len(q.heap) == 0
+}
+
+// Clear removes all the elements of the queue
+func (q *PriorityQueue[T]) Clear() {
+	q.heap = nil
+}
+
+// Clone returns a new queue with the elements of the queue, ordered by the
+// same comparator
+func (q *PriorityQueue[T]) Clone() *PriorityQueue[T] {
+	return &PriorityQueue[T]{heap: slices.Clone(q.heap), comparator: q.comparator}
+}
+
+// Return the string representation of the queue
+func (q *PriorityQueue[T]) String() string {
+	str := "PriorityQueue\n"
+	values := []string{}
+	for _, value := range q.heap {
+		values = append(values, fmt.Sprintf("%v", value))
+	}
+	str += strings.Join(values, ", ")
+	return str
+}
+
+// siftUp moves the element at index i up until its parent is not greater
+func (q *PriorityQueue[T]) siftUp(i int) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if q.comparator(q.heap[i], q.heap[parent]) >= 0 {
+			return
+		}
+		q.heap[i], q.heap[parent] = q.heap[parent], q.heap[i]
+		i = parent
+	}
+}
+
+// siftDown moves the element at index i down until no child is smaller
+func (q *PriorityQueue[T]) siftDown(i int) {
+	size := len(q.heap)
+	for {
+		smallest := i
+		left, right := 2*i+1, 2*i+2
+		if left < size && q.comparator(q.heap[left], q.heap[smallest]) < 0 {
+			smallest = left
+		}
+		if right < size && q.comparator(q.heap[right], q.heap[smallest]) < 0 {
+			smallest = right
+		}
+		if smallest == i {
+			return
+		}
+		q.heap[i], q.heap[smallest] = q.heap[smallest], q.heap[i]
+		i = smallest
+	}
+}
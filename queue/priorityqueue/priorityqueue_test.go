@@ -0,0 +1,189 @@
+package priorityqueue
+
+import (
+	"cmp"
+	"math/rand"
+	"slices"
+	"strings"
+	"testing"
+
+	queues "github.com/TranThang-2804/golangds/queue"
+)
+
+func TestQueueImplementsInterface(t *testing.T) {
+	var _ queues.Queue[int] = New[int](cmp.Compare[int])
+}
+
+func TestQueueEnqueue(t *testing.T) {
+	queue := New[int](cmp.Compare[int])
+	if actualValue := queue.IsEmpty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	queue.Enqueue(3)
+	queue.Enqueue(1)
+	queue.Enqueue(2)
+	if actualValue := queue.Size(); actualValue != 3 {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	if actualValue, ok := queue.Peek(); actualValue != 1 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+}
+
+func TestQueuePeek(t *testing.T) {
+	queue := New[int](cmp.Compare[int])
+	if actualValue, ok := queue.Peek(); actualValue != 0 || ok {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	queue.Enqueue(5)
+	queue.Enqueue(4)
+	if actualValue, ok := queue.Peek(); actualValue != 4 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 4)
+	}
+}
+
+func TestQueueDequeue(t *testing.T) {
+	queue := New[int](func(a, b int) int { return b - a })
+	queue.Enqueue(1)
+	queue.Enqueue(3)
+	queue.Enqueue(2)
+	if actualValue, ok := queue.Dequeue(); actualValue != 3 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	if actualValue, ok := queue.Dequeue(); actualValue != 2 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+	if actualValue, ok := queue.Dequeue(); actualValue != 1 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	if actualValue, ok := queue.Dequeue(); actualValue != 0 || ok {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	if actualValue := queue.IsEmpty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
+func TestQueueDequeueRandom(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	queue := New[int](cmp.Compare[int])
+	values := make([]int, 1000)
+	for i := range values {
+		values[i] = random.Intn(100)
+		queue.Enqueue(values[i])
+	}
+	slices.Sort(values)
+	for _, expectedValue := range values {
+		if actualValue, ok := queue.Dequeue(); actualValue != expectedValue || !ok {
+			t.Fatalf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+}
+
+func TestQueueClear(t *testing.T) {
+	queue := New[int](cmp.Compare[int])
+	queue.Enqueue(1)
+	queue.Clear()
+	if actualValue := queue.Size(); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+}
+
+func TestQueueString(t *testing.T) {
+	c := New[int](cmp.Compare[int])
+	c.Enqueue(1)
+	if !strings.HasPrefix(c.String(), "PriorityQueue") {
+		t.Errorf("String should start with container name")
+	}
+}
+
+func TestQueueClone(t *testing.T) {
+	queue := New[int](cmp.Compare[int])
+	for _, value := range []int{5, 1, 4, 2} {
+		queue.Enqueue(value)
+	}
+	clone := queue.Clone()
+	clone.Enqueue(0)
+	queue.Dequeue()
+
+	for _, test := range []struct {
+		queue         *PriorityQueue[int]
+		expectedValue []int
+	}{
+		{queue, []int{2, 4, 5}},
+		{clone, []int{0, 1, 2, 4, 5}},
+	} {
+		actualValue := []int{}
+		for !test.queue.IsEmpty() {
+			value, _ := test.queue.Dequeue()
+			actualValue = append(actualValue, value)
+		}
+		if !slices.Equal(actualValue, test.expectedValue) {
+			t.Errorf("Got %v expected %v", actualValue, test.expectedValue)
+		}
+	}
+}
+
+func TestQueueAnyType(t *testing.T) {
+	var _ queues.Queue[[]int] = New(func(a, b []int) int { return cmp.Compare(len(a), len(b)) })
+	queue := New(func(a, b []int) int { return cmp.Compare(len(a), len(b)) })
+	queue.Enqueue([]int{1, 2, 3})
+	queue.Enqueue([]int{4})
+	if actualValue, ok := queue.Dequeue(); !slices.Equal(actualValue, []int{4}) || !ok {
+		t.Errorf("Got %v expected %v", actualValue, []int{4})
+	}
+}
+
+func benchmarkEnqueue(b *testing.B, queue *PriorityQueue[int], size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			queue.Enqueue(n)
+		}
+	}
+}
+
+func benchmarkDequeue(b *testing.B, queue *PriorityQueue[int], size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			queue.Dequeue()
+		}
+	}
+}
+
+func BenchmarkPriorityQueueDequeue1000(b *testing.B) {
+	b.StopTimer()
+	size := 1000
+	queue := New[int](cmp.Compare[int])
+	for n := 0; n < size; n++ {
+		queue.Enqueue(n)
+	}
+	b.StartTimer()
+	benchmarkDequeue(b, queue, size)
+}
+
+func BenchmarkPriorityQueueDequeue100000(b *testing.B) {
+	b.StopTimer()
+	size := 100000
+	queue := New[int](cmp.Compare[int])
+	for n := 0; n < size; n++ {
+		queue.Enqueue(n)
+	}
+	b.StartTimer()
+	benchmarkDequeue(b, queue, size)
+}
+
+func BenchmarkPriorityQueueEnqueue1000(b *testing.B) {
+	b.StopTimer()
+	size := 1000
+	queue := New[int](cmp.Compare[int])
+	b.StartTimer()
+	benchmarkEnqueue(b, queue, size)
+}
+
+func BenchmarkPriorityQueueEnqueue100000(b *testing.B) {
+	b.StopTimer()
+	size := 100000
+	queue := New[int](cmp.Compare[int])
+	b.StartTimer()
+	benchmarkEnqueue(b, queue, size)
+}
@@ -1,6 +1,6 @@
 package queues
 
-type Queue[T comparable] interface {
+type Queue[T any] interface {
 	Enqueue(value T)
 	Dequeue() (value T, ok bool)
 	Peek() (value T, ok bool)
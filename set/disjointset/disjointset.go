@@ -0,0 +1,203 @@
+// Package disjointset implements a disjoint-set (union-find) structure.
+//
+// A disjoint-set keeps track of items partitioned into non-overlapping sets.
+// Union by rank and path compression make Find and Union run in amortized
+// O(α(n)) time, where α is the inverse Ackermann function.
+//
+// Reference: https://en.wikipedia.org/wiki/Disjoint-set_data_structure
+package disjointset
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// DisjointSet struct, parent, rank and size are indexed
+// by the position of the item in items
+type DisjointSet[T comparable] struct {
+	items    []T
+	index    map[T]int
+	parent   []int
+	rank     []int
+	size     []int
+	setCount int
+}
+
+// Create a new empty disjoint-set
+func New[T comparable]() *DisjointSet[T] {
+	return &DisjointSet[T]{index: make(map[T]int)}
+}
+
+// MakeSet adds every item as a set of its own, existing items are ignored
+func (d *DisjointSet[T]) MakeSet(items ...T) {
+	for _, item := range items {
+		if _, ok := d.index[item]; ok {
+			continue
+		}
+		i := len(d.items)
+		d.index[item] = i
+		d.items = append(d.items, item)
+		d.parent = append(d.parent, i)
+		d.rank = append(d.rank, 0)
+		d.size = append(d.size, 1)
+		d.setCount++
+	}
+}
+
+// Find returns the representative of the set containing the item
+// return true if the item is found else return false
+func (d *DisjointSet[T]) Find(item T) (T, bool) {
+	i, ok := d.index[item]
+	if !ok {
+		var zeroValue T
+		return zeroValue, false
+	}
+	return d.items[d.root(i)], true
+}
+
+// Union merges the sets containing the two items
+// return true if two different sets were merged else return false
+func (d *DisjointSet[T]) Union(a, b T) bool {
+	i, okA := d.index[a]
+	j, okB := d.index[b]
+	if !okA || !okB {
+		return false
+	}
+
+	rootA, rootB := d.root(i), d.root(j)
+	if rootA == rootB {
+		return false
+	}
+
+	// Attach the shallower tree under the deeper one
+	if d.rank[rootA] < d.rank[rootB] {
+		rootA, rootB = rootB, rootA
+	}
+	d.parent[rootB] = rootA
+	d.size[rootA] += d.size[rootB]
+	if d.rank[rootA] == d.rank[rootB] {
+		d.rank[rootA]++
+	}
+	d.setCount--
+	return true
+}
+
+// Check if the two items are in the same set
+func (d *DisjointSet[T]) Connected(a, b T) bool {
+	i, okA := d.index[a]
+	j, okB := d.index[b]
+	return okA && okB && d.root(i) == d.root(j)
+}
+
+// Check if the item was added to the disjoint-set
+func (d *DisjointSet[T]) Contains(item T) bool {
+	_, ok := d.index[item]
+	return ok
+}
+
+// SizeOf returns the number of items in the set containing the item
+// return true if the item is found else return false
+func (d *DisjointSet[T]) SizeOf(item T) (int, bool) {
+	i, ok := d.index[item]
+	if !ok {
+		return 0, false
+	}
+	return d.size[d.root(i)], true
+}
+
+// SetCount returns the number of disjoint sets
+func (d *DisjointSet[T]) SetCount() int {
+	return d.setCount
+}
+
+// SetSizes maps the representative of every set to the number of items in the set
+func (d *DisjointSet[T]) SetSizes() map[T]int {
+	sizes := make(map[T]int, d.setCount)
+	for i := range d.items {
+		if d.parent[i] == i {
+			sizes[d.items[i]] = d.size[i]
+		}
+	}
+	return sizes
+}
+
+// Sets returns the items grouped by set, sets and items within
+// a set are ordered by the first time they were added
+func (d *DisjointSet[T]) Sets() [][]T {
+	position := make(map[int]int, d.setCount)
+	sets := make([][]T, 0, d.setCount)
+	for i, item := range d.items {
+		root := d.root(i)
+		p, ok := position[root]
+		if !ok {
+			p = len(sets)
+			position[root] = p
+			sets = append(sets, nil)
+		}
+		sets[p] = append(sets[p], item)
+	}
+	return sets
+}
+
+// Get the number of items in the disjoint-set
+func (d *DisjointSet[T]) GetSize() int {
+	return len(d.items)
+}
+
+// Check if the disjoint-set is empty
+func (d *DisjointSet[T]) IsEmpty() bool {
+	return len(d.items) == 0
+}
+
+// Clear all the items of the disjoint-set
+func (d *DisjointSet[T]) Clear() {
+	d.items = nil
+	d.index = make(map[T]int)
+	d.parent = nil
+	d.rank = nil
+	d.size = nil
+	d.setCount = 0
+}
+
+// Clone returns a new disjoint-set with the same items partitioned in the
+// same sets
+func (d *DisjointSet[T]) Clone() *DisjointSet[T] {
+	return &DisjointSet[T]{
+		items:    slices.Clone(d.items),
+		index:    maps.Clone(d.index),
+		parent:   slices.Clone(d.parent),
+		rank:     slices.Clone(d.rank),
+		size:     slices.Clone(d.size),
+		setCount: d.setCount,
+	}
+}
+
+// Return the string representation of the disjoint-set, one set per group
+func (d *DisjointSet[T]) String() string {
+	str := "DisjointSet\n"
+	sets := []string{}
+	for _, set := range d.Sets() {
+		items := []string{}
+		for _, item := range set {
+			items = append(items, fmt.Sprintf("%v", item))
+		}
+		sets = append(sets, "{"+strings.Join(items, ", ")+"}")
+	}
+	str += strings.Join(sets, ", ")
+	return str
+}
+
+// root returns the position of the representative of the item at position i,
+// pointing every item on the way directly at it
+func (d *DisjointSet[T]) root(i int) int {
+	root := i
+	for d.parent[root] != root {
+		root = d.parent[root]
+	}
+	for d.parent[i] != root {
+		i, d.parent[i] = d.parent[i], root
+	}
+	return root
+}
@@ -0,0 +1,186 @@
+package disjointset
+
+import (
+	"maps"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestDisjointSetMakeSet(t *testing.T) {
+	set := New[string]()
+	if actualValue := set.IsEmpty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	set.MakeSet("a", "b", "c")
+	set.MakeSet("a")
+	if actualValue := set.GetSize(); actualValue != 3 {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	if actualValue := set.SetCount(); actualValue != 3 {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	if actualValue, ok := set.Find("b"); actualValue != "b" || !ok {
+		t.Errorf("Got %v expected %v", actualValue, "b")
+	}
+	if actualValue, ok := set.Find("x"); actualValue != "" || ok {
+		t.Errorf("Got %v expected %v", actualValue, "")
+	}
+	if actualValue := set.Contains("c"); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
+func TestDisjointSetUnion(t *testing.T) {
+	set := New[int]()
+	set.MakeSet(1, 2, 3, 4, 5)
+	if actualValue := set.Union(1, 2); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := set.Union(2, 1); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := set.Union(1, 6); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	set.Union(3, 4)
+	set.Union(4, 2)
+	if actualValue := set.Connected(1, 3); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := set.Connected(1, 5); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := set.Connected(1, 6); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := set.SetCount(); actualValue != 2 {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+	if actualValue, ok := set.SizeOf(3); actualValue != 4 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 4)
+	}
+	if actualValue, ok := set.SizeOf(6); actualValue != 0 || ok {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+	root1, _ := set.Find(1)
+	root4, _ := set.Find(4)
+	if root1 != root4 {
+		t.Errorf("Got %v expected %v", root1, root4)
+	}
+}
+
+func TestDisjointSetSizes(t *testing.T) {
+	set := New[string]()
+	set.MakeSet("a", "b", "c", "d")
+	set.Union("a", "c")
+	sizes := set.SetSizes()
+	root, _ := set.Find("a")
+	if actualValue, expectedValue := sizes, map[string]int{root: 2, "b": 1, "d": 1}; !maps.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	expected := [][]string{{"a", "c"}, {"b"}, {"d"}}
+	if actualValue := set.Sets(); !slices.EqualFunc(actualValue, expected, slices.Equal) {
+		t.Errorf("Got %v expected %v", actualValue, expected)
+	}
+}
+
+func TestDisjointSetPathCompression(t *testing.T) {
+	set := New[int]()
+	size := 1 << 10
+	for i := 0; i < size; i++ {
+		set.MakeSet(i)
+	}
+	// Merging equal sized sets pairwise builds trees of logarithmic height
+	for step := 1; step < size; step *= 2 {
+		for i := 0; i+step < size; i += 2 * step {
+			set.Union(i, i+step)
+		}
+	}
+	if actualValue := set.SetCount(); actualValue != 1 {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	if actualValue := set.rank[set.root(0)]; actualValue != 10 {
+		t.Errorf("Got %v expected %v", actualValue, 10)
+	}
+	root := set.root(0)
+	for i := 0; i < size; i++ {
+		set.Find(i)
+		if actualValue := set.parent[i]; actualValue != root {
+			t.Fatalf("Got %v expected %v", actualValue, root)
+		}
+	}
+}
+
+func TestDisjointSetClear(t *testing.T) {
+	set := New[int]()
+	set.MakeSet(1, 2)
+	set.Union(1, 2)
+	set.Clear()
+	if actualValue := set.SetCount(); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+	if actualValue := set.Connected(1, 2); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+}
+
+func TestDisjointSetString(t *testing.T) {
+	set := New[int]()
+	set.MakeSet(1, 2, 3)
+	set.Union(1, 3)
+	if actualValue, expectedValue := set.String(), "DisjointSet\n{1, 3}, {2}"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if !strings.HasPrefix(set.String(), "DisjointSet") {
+		t.Errorf("String should start with container name")
+	}
+}
+
+func TestDisjointSetClone(t *testing.T) {
+	set := New[int]()
+	set.MakeSet(1, 2, 3, 4)
+	set.Union(1, 2)
+	clone := set.Clone()
+	clone.Union(3, 4)
+	set.Union(2, 3)
+
+	if actualValue, expectedValue := set.SetCount(), 2; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := set.Connected(1, 3), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := clone.SetCount(), 2; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := clone.Connected(1, 3), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := clone.Connected(3, 4), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := set.Connected(3, 4), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func benchmarkUnion(b *testing.B, size int) {
+	for i := 0; i < b.N; i++ {
+		set := New[int]()
+		for n := 0; n < size; n++ {
+			set.MakeSet(n)
+		}
+		for n := 1; n < size; n++ {
+			set.Union(n-1, n)
+		}
+	}
+}
+
+func BenchmarkDisjointSetUnion1000(b *testing.B) {
+	benchmarkUnion(b, 1000)
+}
+
+func BenchmarkDisjointSetUnion100000(b *testing.B) {
+	benchmarkUnion(b, 100000)
+}
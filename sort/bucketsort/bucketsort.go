@@ -0,0 +1,167 @@
+// Package bucketsort implements a stable bucket sort keyed by floats.
+//
+// Bucket sort spreads the values over as many buckets as there are values by
+// linear interpolation of their key between the smallest and the largest
+// key, then sorts every bucket. It runs in O(n) expected time when the keys
+// are uniformly distributed and degrades to a comparison sort otherwise.
+//
+// Reference: https://en.wikipedia.org/wiki/Bucket_sort
+package bucketsort
+
+import (
+	"cmp"
+	"math"
+	"slices"
+
+	"github.com/TranThang-2804/golangds/sort/observe"
+)
+
+// Float is a constraint that permits any floating-point type
+type Float interface {
+	~float32 | ~float64
+}
+
+// Arrays of the buffers reported to the observer
+const (
+	bucketsArray observe.Array = 1
+	mergeArray   observe.Array = 2
+)
+
+// Sort sorts the values in place by their float key, keeping values with
+// equal keys in their original order. Like cmp.Compare, NaN keys sort first
+// and infinite keys sort at their ends.
+func Sort[T any, F Float](values []T, key func(T) F) {
+	SortObserved(values, key, nil)
+}
+
+// SortObserved is Sort reporting its operations to the observer. The buckets
+// are laid out one after the other in a buffer, then sorted and moved back.
+func SortObserved[T any, F Float](values []T, key func(T) F, observer observe.Observer) {
+	if len(values) < 2 {
+		return
+	}
+	keys := make([]float64, len(values))
+	low, high := math.Inf(1), math.Inf(-1)
+	for i, value := range values {
+		keys[i] = float64(key(value))
+		if !math.IsNaN(keys[i]) && !math.IsInf(keys[i], 0) {
+			low, high = min(low, keys[i]), max(high, keys[i])
+		}
+	}
+
+	// Bucket 0 holds the NaNs, which are all equal, the others spread the
+	// keys over the range
+	buckets := len(values) + 1
+	last := buckets - 1
+	index := make([]int, len(values))
+	for i, k := range keys {
+		switch {
+		case math.IsNaN(k):
+			index[i] = 0
+		case math.IsInf(k, -1):
+			index[i] = 1
+		case math.IsInf(k, 1):
+			index[i] = last
+		case high > low:
+			// Halving first keeps the width of the range from overflowing
+			index[i] = 1 + int((k/2-low/2)/(high/2-low/2)*float64(last-1))
+			index[i] = min(max(index[i], 1), last)
+		default:
+			index[i] = 1
+		}
+	}
+	start := make([]int, buckets+1)
+	for _, b := range index {
+		start[b+1]++
+	}
+	for b := 1; b <= buckets; b++ {
+		start[b] += start[b-1]
+	}
+
+	s := &sorter[T]{observer: observe.Scope(observer)}
+	s.arrays[observe.Values], s.keys[observe.Values] = values, keys
+	s.arrays[bucketsArray], s.keys[bucketsArray] = s.alloc(bucketsArray, len(values))
+	next := slices.Clone(start[:buckets])
+	for i, b := range index {
+		s.move(observe.At(i), observe.Position{Array: bucketsArray, Index: next[b]})
+		next[b]++
+	}
+	for b := 1; b < buckets; b++ {
+		s.sort(start[b], start[b+1])
+	}
+	for i := range values {
+		s.move(observe.Position{Array: bucketsArray, Index: i}, observe.At(i))
+	}
+}
+
+// sorter merge sorts the buckets by key
+type sorter[T any] struct {
+	observer observe.Observer
+	// arrays holds the values, the buckets and the merge buffer along with
+	// their keys
+	arrays [3][]T
+	keys   [3][]float64
+}
+
+func (s *sorter[T]) alloc(array observe.Array, size int) ([]T, []float64) {
+	if s.observer != nil {
+		s.observer.Alloc(array, size)
+	}
+	return make([]T, size), make([]float64, size)
+}
+
+func (s *sorter[T]) compare(a, b observe.Position) int {
+	order := cmp.Compare(s.keys[a.Array][a.Index], s.keys[b.Array][b.Index])
+	if s.observer != nil {
+		s.observer.Compare(a, b, order)
+	}
+	return order
+}
+
+func (s *sorter[T]) move(from, to observe.Position) {
+	s.arrays[to.Array][to.Index] = s.arrays[from.Array][from.Index]
+	s.keys[to.Array][to.Index] = s.keys[from.Array][from.Index]
+	if s.observer != nil {
+		s.observer.Move(from, to)
+	}
+}
+
+// sort sorts the bucket at [low:high] of the buckets array, stable
+func (s *sorter[T]) sort(low, high int) {
+	if high-low < 2 {
+		return
+	}
+	middle := low + (high-low)/2
+	s.sort(low, middle)
+	s.sort(middle, high)
+
+	bucket := func(i int) observe.Position { return observe.Position{Array: bucketsArray, Index: i} }
+	buffer := func(i int) observe.Position { return observe.Position{Array: mergeArray, Index: i} }
+	// Already in order, nothing to merge
+	if s.compare(bucket(middle-1), bucket(middle)) <= 0 {
+		return
+	}
+	if s.arrays[mergeArray] == nil {
+		s.arrays[mergeArray], s.keys[mergeArray] = s.alloc(mergeArray, len(s.arrays[bucketsArray]))
+	}
+	for i := low; i < high; i++ {
+		s.move(bucket(i), buffer(i))
+	}
+	i, j, k := low, middle, low
+	for i < middle && j < high {
+		if s.compare(buffer(j), buffer(i)) < 0 {
+			s.move(buffer(j), bucket(k))
+			j++
+		} else {
+			s.move(buffer(i), bucket(k))
+			i++
+		}
+		k++
+	}
+	for ; i < middle; i, k = i+1, k+1 {
+		s.move(buffer(i), bucket(k))
+	}
+	for ; j < high; j, k = j+1, k+1 {
+		s.move(buffer(j), bucket(k))
+	}
+}
@@ -0,0 +1,81 @@
+package bucketsort
+
+import (
+	"cmp"
+	"math"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/TranThang-2804/golangds/list"
+	"github.com/TranThang-2804/golangds/sort/internal/sorttest"
+	"github.com/TranThang-2804/golangds/sort/observe"
+)
+
+func TestSort(t *testing.T) {
+	sorttest.Run(t, func(values []sorttest.Item, _ list.Comparator[sorttest.Item]) {
+		Sort(values, func(item sorttest.Item) float64 { return float64(item.Key) })
+	}, true)
+}
+
+func TestSortObserved(t *testing.T) {
+	sorttest.RunObserved(t, func(values []sorttest.Item, _ list.Comparator[sorttest.Item], observer observe.Observer) {
+		SortObserved(values, func(item sorttest.Item) float64 { return float64(item.Key) }, observer)
+	}, true)
+}
+
+func TestSpecialValues(t *testing.T) {
+	nan := math.NaN()
+	values := []float64{1.5, math.Inf(1), nan, -2, math.Inf(-1), 0, nan, 1.5, math.MaxFloat64, -math.MaxFloat64}
+	Sort(values, func(k float64) float64 { return k })
+	expected := slices.Clone(values)
+	slices.SortFunc(expected, cmp.Compare[float64])
+	for i := range values {
+		if cmp.Compare(values[i], expected[i]) != 0 {
+			t.Errorf("Got %v expected %v", values, expected)
+			break
+		}
+	}
+}
+
+func TestOnlyNaN(t *testing.T) {
+	values := []float32{float32(math.NaN()), float32(math.NaN())}
+	Sort(values, func(k float32) float32 { return k })
+	if actualValue := len(values); actualValue != 2 {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+}
+
+func TestSkewed(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	values := make([]float64, 1000)
+	for i := range values {
+		values[i] = math.Exp(random.Float64() * 50)
+	}
+	Sort(values, func(k float64) float64 { return k })
+	if !slices.IsSorted(values) {
+		t.Errorf("Got %v which is not sorted", values)
+	}
+}
+
+func benchmark(b *testing.B, sort func([]float64)) {
+	random := rand.New(rand.NewSource(1))
+	input := make([]float64, 100000)
+	for i := range input {
+		input[i] = random.Float64()
+	}
+	values := make([]float64, len(input))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		copy(values, input)
+		sort(values)
+	}
+}
+
+func BenchmarkSort100000(b *testing.B) {
+	benchmark(b, func(values []float64) { Sort(values, func(k float64) float64 { return k }) })
+}
+
+func BenchmarkSortFunc100000(b *testing.B) {
+	benchmark(b, func(values []float64) { slices.SortFunc(values, cmp.Compare[float64]) })
+}
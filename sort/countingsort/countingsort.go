@@ -0,0 +1,130 @@
+// Package countingsort implements a stable counting sort keyed by integers.
+//
+// Counting sort counts how many values have every key between the smallest
+// and the largest one, then places the values directly at their final
+// position. It runs in O(n+k) time and space where k is the size of the key
+// range, so it only pays off when the keys fall in a small range. When the
+// range is much wider than the number of values, the sorts fall back to an LSD
+// radix sort instead of allocating the counts.
+//
+// Reference: https://en.wikipedia.org/wiki/Counting_sort
+package countingsort
+
+import (
+	"github.com/TranThang-2804/golangds/sort/observe"
+	"github.com/TranThang-2804/golangds/sort/radixsort"
+)
+
+// sortedArray is the array of the buffer the values are placed into
+const sortedArray observe.Array = 1
+
+// maxSpread is the number of counts per value above which the sorts fall
+// back to a radix sort, minCounts the number of counts always allowed
+const (
+	maxSpread = 16
+	minCounts = 1 << 16
+)
+
+// Integer is a constraint that permits any integer type
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// Sort sorts the values in place by their integer key, keeping values with
+// equal keys in their original order. The key range is taken from the
+// smallest and the largest key, the values are radix sorted instead if it is
+// too wide for their number.
+func Sort[T any, K Integer](values []T, key func(T) K) {
+	SortObserved(values, key, nil)
+}
+
+// SortObserved is Sort reporting its operations to the observer. The values
+// are moved into a buffer at their final position, then back.
+func SortObserved[T any, K Integer](values []T, key func(T) K, observer observe.Observer) {
+	if len(values) < 2 {
+		return
+	}
+	keys := make([]K, len(values))
+	low, high := key(values[0]), key(values[0])
+	for i, value := range values {
+		keys[i] = key(value)
+		low, high = min(low, keys[i]), max(high, keys[i])
+	}
+	if !countable(low, high, len(values)) {
+		radixsort.LSDObserved(values, key, observer)
+		return
+	}
+	sortKeys(values, keys, low, high, observe.Scope(observer))
+}
+
+// SortRange sorts the values in place by their integer key when every key
+// is known to be between low and high included, so that the counts can be
+// sized up front. The values are radix sorted instead if the range is too
+// wide for their number.
+// return false and leave the values untouched if a key is out of range
+func SortRange[T any, K Integer](values []T, key func(T) K, low, high K) bool {
+	return SortRangeObserved(values, key, low, high, nil)
+}
+
+// SortRangeObserved is SortRange reporting its operations to the observer
+func SortRangeObserved[T any, K Integer](values []T, key func(T) K, low, high K, observer observe.Observer) bool {
+	keys := make([]K, len(values))
+	for i, value := range values {
+		keys[i] = key(value)
+		if keys[i] < low || keys[i] > high {
+			return false
+		}
+	}
+	switch {
+	case len(values) < 2:
+	case !countable(low, high, len(values)):
+		radixsort.LSDObserved(values, key, observer)
+	default:
+		sortKeys(values, keys, low, high, observe.Scope(observer))
+	}
+	return true
+}
+
+// countable reports whether the counts of the keys from low to high are few
+// enough for n values. The width of the range is computed in the unsigned
+// domain so that it does not overflow for signed keys, and is checked before
+// adding one so that a range of every uint64 does not wrap to zero.
+func countable[K Integer](low, high K, n int) bool {
+	width := uint64(high) - uint64(low)
+	return width < max(uint64(n)*maxSpread, minCounts)
+}
+
+// sortKeys places the values by their precomputed keys, all within a
+// countable range
+func sortKeys[T any, K Integer](values []T, keys []K, low, high K, observer observe.Observer) {
+	count := make([]int, uint64(high)-uint64(low)+1)
+	for _, k := range keys {
+		count[uint64(k)-uint64(low)]++
+	}
+
+	// Turn the counts into the start of every key
+	offset := 0
+	for k, c := range count {
+		count[k] = offset
+		offset += c
+	}
+	sorted := make([]T, len(values))
+	if observer != nil {
+		observer.Alloc(sortedArray, len(sorted))
+	}
+	for i, value := range values {
+		k := uint64(keys[i]) - uint64(low)
+		sorted[count[k]] = value
+		if observer != nil {
+			observer.Move(observe.At(i), observe.Position{Array: sortedArray, Index: count[k]})
+		}
+		count[k]++
+	}
+	copy(values, sorted)
+	if observer != nil {
+		for i := range values {
+			observer.Move(observe.Position{Array: sortedArray, Index: i}, observe.At(i))
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package countingsort
+
+import (
+	"cmp"
+	"math"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/TranThang-2804/golangds/list"
+	"github.com/TranThang-2804/golangds/sort/internal/sorttest"
+	"github.com/TranThang-2804/golangds/sort/observe"
+)
+
+func itemKey(item sorttest.Item) int {
+	return item.Key
+}
+
+func TestSort(t *testing.T) {
+	sorttest.Run(t, func(values []sorttest.Item, _ list.Comparator[sorttest.Item]) {
+		Sort(values, itemKey)
+	}, true)
+}
+
+func TestSortRange(t *testing.T) {
+	sorttest.Run(t, func(values []sorttest.Item, _ list.Comparator[sorttest.Item]) {
+		if !SortRange(values, itemKey, 0, 1000) {
+			t.Errorf("Got %v expected %v", false, true)
+		}
+	}, true)
+
+	values := []int{3, 1, 2}
+	if actualValue := SortRange(values, func(k int) int { return k }, 1, 2); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue, expectedValue := values, []int{3, 1, 2}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestObserved(t *testing.T) {
+	sorttest.RunObserved(t, func(values []sorttest.Item, _ list.Comparator[sorttest.Item], observer observe.Observer) {
+		SortObserved(values, itemKey, observer)
+	}, true)
+	sorttest.RunObserved(t, func(values []sorttest.Item, _ list.Comparator[sorttest.Item], observer observe.Observer) {
+		SortRangeObserved(values, itemKey, 0, 1000, observer)
+	}, true)
+}
+
+func TestSignedExtremes(t *testing.T) {
+	values := []int8{math.MaxInt8, math.MinInt8, 0, -1, 1, math.MinInt8}
+	Sort(values, func(k int8) int8 { return k })
+	if actualValue, expectedValue := values, []int8{math.MinInt8, math.MinInt8, -1, 0, 1, math.MaxInt8}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestWideRange(t *testing.T) {
+	// The counts of these ranges would not fit in memory, or wrap to zero
+	ints := []int{math.MaxInt64, 3, 0, math.MinInt64, 3}
+	Sort(ints, func(k int) int { return k })
+	if actualValue, expectedValue := ints, []int{math.MinInt64, 0, 3, 3, math.MaxInt64}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	ints = []int{math.MaxInt64, 0}
+	Sort(ints, func(k int) int { return k })
+	if actualValue, expectedValue := ints, []int{0, math.MaxInt64}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	uints := []uint64{math.MaxUint64, 0}
+	Sort(uints, func(k uint64) uint64 { return k })
+	if actualValue, expectedValue := uints, []uint64{0, math.MaxUint64}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	uints = []uint64{math.MaxUint64, 7, 0}
+	if actualValue := SortRange(uints, func(k uint64) uint64 { return k }, 0, math.MaxUint64); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue, expectedValue := uints, []uint64{0, 7, math.MaxUint64}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// Stable on the fallback too
+	type pair struct{ key, order int }
+	pairs := []pair{{math.MaxInt64, 0}, {1, 1}, {math.MaxInt64, 2}, {1, 3}}
+	Sort(pairs, func(p pair) int { return p.key })
+	if actualValue, expectedValue := pairs, []pair{{1, 1}, {1, 3}, {math.MaxInt64, 0}, {math.MaxInt64, 2}}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestWideRangeObserved(t *testing.T) {
+	sorttest.RunObserved(t, func(values []sorttest.Item, _ list.Comparator[sorttest.Item], observer observe.Observer) {
+		SortObserved(values, func(item sorttest.Item) int { return item.Key << 40 }, observer)
+	}, true)
+}
+
+func randomGrades(n int) []uint8 {
+	random := rand.New(rand.NewSource(1))
+	values := make([]uint8, n)
+	for i := range values {
+		values[i] = uint8(random.Intn(101))
+	}
+	return values
+}
+
+func benchmark(b *testing.B, sort func([]uint8)) {
+	input := randomGrades(100000)
+	values := make([]uint8, len(input))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		copy(values, input)
+		sort(values)
+	}
+}
+
+func BenchmarkSort100000(b *testing.B) {
+	benchmark(b, func(values []uint8) { Sort(values, func(k uint8) uint8 { return k }) })
+}
+
+func BenchmarkSortRange100000(b *testing.B) {
+	benchmark(b, func(values []uint8) { SortRange(values, func(k uint8) uint8 { return k }, 0, 100) })
+}
+
+func BenchmarkSortFunc100000(b *testing.B) {
+	benchmark(b, func(values []uint8) { slices.SortFunc(values, cmp.Compare[uint8]) })
+}
@@ -0,0 +1,73 @@
+package externalsort
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+	"strings"
+)
+
+// Codec reads and writes the records of a stream
+type Codec[T any] interface {
+	// Encode writes one record
+	Encode(w *bufio.Writer, record T) error
+	// Decode reads one record, it returns io.EOF when the stream ends
+	// between records and io.ErrUnexpectedEOF when it ends inside one
+	Decode(r *bufio.Reader) (T, error)
+	// Size estimates the memory taken by a decoded record in bytes
+	Size(record T) int
+}
+
+// LineCodec reads and writes newline terminated strings. The newline is not
+// part of the record and the last line of the input may omit it.
+type LineCodec struct{}
+
+// Encode writes the line followed by a newline
+func (LineCodec) Encode(w *bufio.Writer, record string) error {
+	if _, err := w.WriteString(record); err != nil {
+		return err
+	}
+	return w.WriteByte('\n')
+}
+
+// Decode reads a line without its newline
+func (LineCodec) Decode(r *bufio.Reader) (string, error) {
+	line, err := r.ReadString('\n')
+	if err == io.EOF && len(line) > 0 {
+		return line, nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(line, "\n"), nil
+}
+
+// Size counts the bytes of the line and of the string header
+func (LineCodec) Size(record string) int {
+	return len(record) + 16
+}
+
+// Int64Codec reads and writes big-endian 8-byte integers
+type Int64Codec struct{}
+
+// Encode writes the integer
+func (Int64Codec) Encode(w *bufio.Writer, record int64) error {
+	var buffer [8]byte
+	binary.BigEndian.PutUint64(buffer[:], uint64(record))
+	_, err := w.Write(buffer[:])
+	return err
+}
+
+// Decode reads an integer
+func (Int64Codec) Decode(r *bufio.Reader) (int64, error) {
+	var buffer [8]byte
+	if _, err := io.ReadFull(r, buffer[:]); err != nil {
+		return 0, err
+	}
+	return int64(binary.BigEndian.Uint64(buffer[:])), nil
+}
+
+// Size is the size of an integer
+func (Int64Codec) Size(int64) int {
+	return 8
+}
@@ -0,0 +1,263 @@
+// Package externalsort sorts streams of records that do not fit in memory.
+//
+// The input is read in chunks bounded by a memory budget. Every chunk is
+// sorted in memory and written to a temporary file as a sorted run, then the
+// runs are merged with a heap, at most FanIn at a time, straight into the
+// output. Records are read and written by a Codec. The sort is stable: runs
+// keep the input order of equal records and ties between runs go to the
+// earlier run.
+//
+// Reference: https://en.wikipedia.org/wiki/External_sorting
+package externalsort
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/TranThang-2804/golangds/list"
+	"github.com/TranThang-2804/golangds/queue/priorityqueue"
+	"github.com/TranThang-2804/golangds/sort/mergesort"
+	"github.com/TranThang-2804/golangds/sort/observe"
+)
+
+// DefaultMemoryLimit is the memory budget in bytes when Options.MemoryLimit is not set
+const DefaultMemoryLimit = 64 << 20
+
+// DefaultFanIn is the number of runs merged at once when Options.FanIn is not set
+const DefaultFanIn = 64
+
+// minBufferSize is the smallest read or write buffer given to a run
+const minBufferSize = 16
+
+// Options tunes the external sort
+type Options struct {
+	// MemoryLimit bounds the estimated size of the records held in memory
+	// and of the merge buffers, DefaultMemoryLimit if not positive. A chunk
+	// always holds at least one record, however large.
+	MemoryLimit int
+	// FanIn is the maximum number of runs merged at once, runs are merged
+	// in several passes beyond it, DefaultFanIn if less than 2
+	FanIn int
+	// TempDir is the directory of the run files, os.TempDir() if empty
+	TempDir string
+	// Observer, if not nil, is reported the operations of the sorts of the
+	// chunks in memory, one chunk after the other and each as the Values
+	// array. Merging the runs is not reported.
+	Observer observe.Observer
+}
+
+func (o Options) withDefaults() Options {
+	if o.MemoryLimit <= 0 {
+		o.MemoryLimit = DefaultMemoryLimit
+	}
+	if o.FanIn < 2 {
+		o.FanIn = DefaultFanIn
+	}
+	return o
+}
+
+// Sort reads the records from r, sorts them with the comparator and writes
+// them to w with the codec, using the default options
+func Sort[T any](r io.Reader, w io.Writer, codec Codec[T], comparator list.Comparator[T]) error {
+	return SortWith(r, w, codec, comparator, Options{})
+}
+
+// SortWith reads the records from r, sorts them with the comparator and
+// writes them to w with the codec, tuned by the options. The run files are
+// removed before it returns, even on error.
+func SortWith[T any](r io.Reader, w io.Writer, codec Codec[T], comparator list.Comparator[T], options Options) (err error) {
+	s := &sorter[T]{codec: codec, comparator: comparator, options: options.withDefaults()}
+	defer func() {
+		if removeErr := s.removeRuns(s.runs); err == nil {
+			err = removeErr
+		}
+	}()
+
+	input := bufio.NewReader(r)
+	output := bufio.NewWriter(w)
+	var chunk []T
+	size := 0
+	for {
+		record, err := codec.Decode(input)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("externalsort: decode input: %w", err)
+		}
+		recordSize := codec.Size(record)
+		if len(chunk) > 0 && size+recordSize > s.options.MemoryLimit {
+			if err := s.writeRun(chunk); err != nil {
+				return err
+			}
+			chunk, size = chunk[:0], 0
+		}
+		chunk = append(chunk, record)
+		size += recordSize
+	}
+
+	// Everything fit in memory, no need for runs
+	if len(s.runs) == 0 {
+		mergesort.TopDownObserved(chunk, comparator, s.options.Observer)
+		for _, record := range chunk {
+			if err := codec.Encode(output, record); err != nil {
+				return fmt.Errorf("externalsort: encode output: %w", err)
+			}
+		}
+		return output.Flush()
+	}
+	if len(chunk) > 0 {
+		if err := s.writeRun(chunk); err != nil {
+			return err
+		}
+	}
+	chunk = nil
+
+	// Merge FanIn runs at a time into new runs until one pass is enough
+	for len(s.runs) > s.options.FanIn {
+		var merged []*os.File
+		for low := 0; low < len(s.runs); low += s.options.FanIn {
+			group := s.runs[low:min(low+s.options.FanIn, len(s.runs))]
+			run, err := s.createRun()
+			if err != nil {
+				s.removeRuns(merged)
+				return err
+			}
+			// Track the new run for removal before anything can fail
+			merged = append(merged, run)
+			if err := s.merge(group, run); err != nil {
+				s.removeRuns(merged)
+				return err
+			}
+		}
+		if err := s.removeRuns(s.runs); err != nil {
+			s.removeRuns(merged)
+			return err
+		}
+		s.runs = merged
+	}
+	if err := s.merge(s.runs, output); err != nil {
+		return err
+	}
+	return nil
+}
+
+// createTemp creates the run files, replaced by the tests to make it fail
+var createTemp = os.CreateTemp
+
+type sorter[T any] struct {
+	codec      Codec[T]
+	comparator list.Comparator[T]
+	options    Options
+	// runs are the files of the sorted runs in input order
+	runs []*os.File
+}
+
+func (s *sorter[T]) createRun() (*os.File, error) {
+	file, err := createTemp(s.options.TempDir, "externalsort-*")
+	if err != nil {
+		return nil, fmt.Errorf("externalsort: create run: %w", err)
+	}
+	return file, nil
+}
+
+// writeRun sorts the chunk and writes it to a new run file
+func (s *sorter[T]) writeRun(chunk []T) error {
+	mergesort.TopDownObserved(chunk, s.comparator, s.options.Observer)
+	run, err := s.createRun()
+	if err != nil {
+		return err
+	}
+	s.runs = append(s.runs, run)
+
+	output := bufio.NewWriterSize(run, s.bufferSize(1))
+	for _, record := range chunk {
+		if err := s.codec.Encode(output, record); err != nil {
+			return fmt.Errorf("externalsort: encode run: %w", err)
+		}
+	}
+	if err := output.Flush(); err != nil {
+		return fmt.Errorf("externalsort: write run: %w", err)
+	}
+	return nil
+}
+
+// head is the smallest record not yet merged of a run
+type head[T any] struct {
+	record T
+	run    int
+}
+
+// merge merges the runs into w with a heap of their heads
+func (s *sorter[T]) merge(runs []*os.File, w io.Writer) error {
+	bufferSize := s.bufferSize(len(runs) + 1)
+	output, ok := w.(*bufio.Writer)
+	if !ok {
+		output = bufio.NewWriterSize(w, bufferSize)
+	}
+
+	// Ties go to the earlier run to keep the sort stable
+	heads := priorityqueue.New(func(a, b head[T]) int {
+		if order := s.comparator(a.record, b.record); order != 0 {
+			return order
+		}
+		return a.run - b.run
+	})
+	inputs := make([]*bufio.Reader, len(runs))
+	for i, run := range runs {
+		if _, err := run.Seek(0, io.SeekStart); err != nil {
+			return fmt.Errorf("externalsort: rewind run: %w", err)
+		}
+		inputs[i] = bufio.NewReaderSize(run, bufferSize)
+		if err := s.next(heads, inputs[i], i); err != nil {
+			return err
+		}
+	}
+
+	for !heads.IsEmpty() {
+		smallest, _ := heads.Dequeue()
+		if err := s.codec.Encode(output, smallest.record); err != nil {
+			return fmt.Errorf("externalsort: encode output: %w", err)
+		}
+		if err := s.next(heads, inputs[smallest.run], smallest.run); err != nil {
+			return err
+		}
+	}
+	if err := output.Flush(); err != nil {
+		return fmt.Errorf("externalsort: write output: %w", err)
+	}
+	return nil
+}
+
+// next reads the next record of a run into the heap, if any
+func (s *sorter[T]) next(heads *priorityqueue.PriorityQueue[head[T]], input *bufio.Reader, run int) error {
+	record, err := s.codec.Decode(input)
+	if err == io.EOF {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("externalsort: decode run: %w", err)
+	}
+	heads.Enqueue(head[T]{record: record, run: run})
+	return nil
+}
+
+// bufferSize splits the memory budget between the buffers
+func (s *sorter[T]) bufferSize(buffers int) int {
+	return max(s.options.MemoryLimit/buffers, minBufferSize)
+}
+
+// removeRuns closes and deletes the run files
+func (s *sorter[T]) removeRuns(runs []*os.File) error {
+	var errs []error
+	for _, run := range runs {
+		errs = append(errs, run.Close(), os.Remove(run.Name()))
+	}
+	if err := errors.Join(errs...); err != nil {
+		return fmt.Errorf("externalsort: remove runs: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,233 @@
+package externalsort
+
+import (
+	"bufio"
+	"bytes"
+	"cmp"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"os"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func randomLines(n int) []string {
+	random := rand.New(rand.NewSource(1))
+	lines := make([]string, n)
+	for i := range lines {
+		line := make([]byte, random.Intn(12))
+		for j := range line {
+			line[j] = byte('a' + random.Intn(4))
+		}
+		lines[i] = string(line)
+	}
+	return lines
+}
+
+func join(lines []string) string {
+	if len(lines) == 0 {
+		return ""
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
+
+// assertNoRuns checks that the run files were removed
+func assertNoRuns(t *testing.T, dir string) {
+	t.Helper()
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if actualValue := len(entries); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+}
+
+func TestSortLines(t *testing.T) {
+	lines := randomLines(1000)
+	expected := slices.Clone(lines)
+	slices.Sort(expected)
+
+	for _, options := range []Options{
+		{MemoryLimit: 64},
+		{MemoryLimit: 64, FanIn: 2},
+		{MemoryLimit: 1 << 20},
+		{MemoryLimit: 1},
+	} {
+		options.TempDir = t.TempDir()
+		var output bytes.Buffer
+		if err := SortWith(strings.NewReader(join(lines)), &output, LineCodec{}, strings.Compare, options); err != nil {
+			t.Fatalf("Got %v expected %v", err, nil)
+		}
+		if actualValue, expectedValue := output.String(), join(expected); actualValue != expectedValue {
+			t.Errorf("%+v: output is not sorted", options)
+		}
+		assertNoRuns(t, options.TempDir)
+	}
+}
+
+func TestSortEmpty(t *testing.T) {
+	var output bytes.Buffer
+	if err := Sort(strings.NewReader(""), &output, LineCodec{}, strings.Compare); err != nil {
+		t.Fatalf("Got %v expected %v", err, nil)
+	}
+	if actualValue := output.Len(); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+}
+
+func TestLastLineWithoutNewline(t *testing.T) {
+	var output bytes.Buffer
+	SortWith(strings.NewReader("c\na\nb"), &output, LineCodec{}, strings.Compare, Options{MemoryLimit: 1, TempDir: t.TempDir()})
+	if actualValue, expectedValue := output.String(), "a\nb\nc\n"; actualValue != expectedValue {
+		t.Errorf("Got %q expected %q", actualValue, expectedValue)
+	}
+}
+
+func TestSortInt64(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	values := make([]int64, 5000)
+	var input bytes.Buffer
+	writer := bufio.NewWriter(&input)
+	for i := range values {
+		values[i] = random.Int63() - random.Int63()
+		Int64Codec{}.Encode(writer, values[i])
+	}
+	writer.Flush()
+	slices.Sort(values)
+
+	var output bytes.Buffer
+	dir := t.TempDir()
+	// 100 records per run, merged 8 at a time
+	if err := SortWith(&input, &output, Int64Codec{}, cmp.Compare[int64], Options{MemoryLimit: 800, FanIn: 8, TempDir: dir}); err != nil {
+		t.Fatalf("Got %v expected %v", err, nil)
+	}
+	reader := bufio.NewReader(&output)
+	for i, expectedValue := range values {
+		actualValue, err := Int64Codec{}.Decode(reader)
+		if err != nil || actualValue != expectedValue {
+			t.Fatalf("Got %v, %v at %d expected %v", actualValue, err, i, expectedValue)
+		}
+	}
+	if _, err := (Int64Codec{}).Decode(reader); err != io.EOF {
+		t.Errorf("Got %v expected %v", err, io.EOF)
+	}
+	assertNoRuns(t, dir)
+}
+
+// recordCodec writes "key order" lines and sorts by key only
+type recordCodec struct{ LineCodec }
+
+type record struct {
+	key   int
+	order int
+}
+
+func (recordCodec) Encode(w *bufio.Writer, r record) error {
+	_, err := fmt.Fprintf(w, "%d %d\n", r.key, r.order)
+	return err
+}
+
+func (c recordCodec) Decode(r *bufio.Reader) (record, error) {
+	line, err := c.LineCodec.Decode(r)
+	if err != nil {
+		return record{}, err
+	}
+	var rec record
+	_, err = fmt.Sscanf(line, "%d %d", &rec.key, &rec.order)
+	return rec, err
+}
+
+func (recordCodec) Size(record) int {
+	return 16
+}
+
+func TestStable(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	var input strings.Builder
+	for i := 0; i < 2000; i++ {
+		fmt.Fprintf(&input, "%d %d\n", random.Intn(10), i)
+	}
+
+	var output bytes.Buffer
+	compare := func(a, b record) int { return cmp.Compare(a.key, b.key) }
+	if err := SortWith(strings.NewReader(input.String()), &output, recordCodec{}, compare, Options{MemoryLimit: 160, FanIn: 3, TempDir: t.TempDir()}); err != nil {
+		t.Fatalf("Got %v expected %v", err, nil)
+	}
+	reader := bufio.NewReader(&output)
+	previous, count := record{key: -1}, 0
+	for {
+		current, err := recordCodec{}.Decode(reader)
+		if err == io.EOF {
+			break
+		}
+		if current.key < previous.key || (current.key == previous.key && current.order < previous.order) {
+			t.Fatalf("Got %v after %v", current, previous)
+		}
+		previous = current
+		count++
+	}
+	if actualValue := count; actualValue != 2000 {
+		t.Errorf("Got %v expected %v", actualValue, 2000)
+	}
+}
+
+type failingReader struct {
+	data io.Reader
+}
+
+var errRead = errors.New("read failed")
+
+func (r *failingReader) Read(p []byte) (int, error) {
+	n, err := r.data.Read(p)
+	if err == io.EOF {
+		return n, errRead
+	}
+	return n, err
+}
+
+func TestErrors(t *testing.T) {
+	dir := t.TempDir()
+	err := SortWith(&failingReader{data: strings.NewReader(join(randomLines(100)))}, io.Discard, LineCodec{}, strings.Compare, Options{MemoryLimit: 64, TempDir: dir})
+	if !errors.Is(err, errRead) {
+		t.Errorf("Got %v expected %v", err, errRead)
+	}
+	assertNoRuns(t, dir)
+
+	// Half an integer at the end of the input
+	err = Sort(bytes.NewReader(make([]byte, 12)), io.Discard, Int64Codec{}, cmp.Compare[int64])
+	if !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Errorf("Got %v expected %v", err, io.ErrUnexpectedEOF)
+	}
+
+	err = SortWith(strings.NewReader("b\na\n"), io.Discard, LineCodec{}, strings.Compare, Options{MemoryLimit: 1, TempDir: dir + "/missing"})
+	if err == nil {
+		t.Errorf("Got %v expected an error", err)
+	}
+}
+
+func TestCreateRunFailsDuringMerge(t *testing.T) {
+	dir := t.TempDir()
+	errCreate := errors.New("create failed")
+	calls := 0
+	createTemp = func(dir, pattern string) (*os.File, error) {
+		// 4 runs of one line, then the second run of the first merge pass
+		if calls++; calls == 6 {
+			return nil, errCreate
+		}
+		return os.CreateTemp(dir, pattern)
+	}
+	defer func() { createTemp = os.CreateTemp }()
+
+	err := SortWith(strings.NewReader("d\nc\nb\na\n"), io.Discard, LineCodec{}, strings.Compare, Options{MemoryLimit: 1, FanIn: 2, TempDir: dir})
+	if !errors.Is(err, errCreate) {
+		t.Errorf("Got %v expected %v", err, errCreate)
+	}
+	if actualValue, expectedValue := calls, 6; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	assertNoRuns(t, dir)
+}
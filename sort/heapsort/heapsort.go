@@ -0,0 +1,71 @@
+// Package heapsort implements heap sort of slices.
+//
+// Heap sort builds a max-heap over the values and repeatedly moves its root
+// behind the heap. It runs in O(n log n) time and O(1) extra space but is
+// not stable.
+//
+// Reference: https://en.wikipedia.org/wiki/Heapsort
+package heapsort
+
+import (
+	"github.com/TranThang-2804/golangds/list"
+	"github.com/TranThang-2804/golangds/sort/observe"
+)
+
+// Sort sorts the values in place
+func Sort[T any](values []T, comparator list.Comparator[T]) {
+	SortObserved(values, comparator, nil)
+}
+
+// SortObserved is Sort reporting its operations to the observer
+func SortObserved[T any](values []T, comparator list.Comparator[T], observer observe.Observer) {
+	h := &heap[T]{values: values, comparator: comparator, observer: observe.Scope(observer)}
+	for i := len(values)/2 - 1; i >= 0; i-- {
+		h.siftDown(i, len(values))
+	}
+	for end := len(values) - 1; end > 0; end-- {
+		h.swap(0, end)
+		h.siftDown(0, end)
+	}
+}
+
+type heap[T any] struct {
+	values     []T
+	comparator list.Comparator[T]
+	observer   observe.Observer
+}
+
+func (h *heap[T]) compare(i, j int) int {
+	order := h.comparator(h.values[i], h.values[j])
+	if h.observer != nil {
+		h.observer.Compare(observe.At(i), observe.At(j), order)
+	}
+	return order
+}
+
+func (h *heap[T]) swap(i, j int) {
+	h.values[i], h.values[j] = h.values[j], h.values[i]
+	if h.observer != nil {
+		h.observer.Swap(observe.At(i), observe.At(j))
+	}
+}
+
+// siftDown moves the value at index down until both children within
+// values[:size] are not greater
+func (h *heap[T]) siftDown(index, size int) {
+	for {
+		largest := index
+		left, right := 2*index+1, 2*index+2
+		if left < size && h.compare(left, largest) > 0 {
+			largest = left
+		}
+		if right < size && h.compare(right, largest) > 0 {
+			largest = right
+		}
+		if largest == index {
+			return
+		}
+		h.swap(index, largest)
+		index = largest
+	}
+}
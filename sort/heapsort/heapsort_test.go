@@ -0,0 +1,34 @@
+package heapsort
+
+import (
+	"testing"
+
+	"github.com/TranThang-2804/golangds/sort/internal/sorttest"
+)
+
+func TestSort(t *testing.T) {
+	sorttest.Run(t, Sort[sorttest.Item], false)
+}
+
+func TestSortObserved(t *testing.T) {
+	sorttest.RunObserved(t, SortObserved[sorttest.Item], false)
+}
+
+func TestSortStrings(t *testing.T) {
+	values := []string{"pear", "apple", "fig", "banana"}
+	Sort(values, func(a, b string) int {
+		switch {
+		case a < b:
+			return -1
+		case a > b:
+			return 1
+		}
+		return 0
+	})
+	expected := []string{"apple", "banana", "fig", "pear"}
+	for i := range expected {
+		if actualValue, expectedValue := values[i], expected[i]; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+}
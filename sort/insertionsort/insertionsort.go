@@ -0,0 +1,101 @@
+// Package insertionsort implements stable insertion sorts of slices.
+//
+// Insertion sort grows a sorted prefix by inserting one value at a time.
+// It runs in O(n^2) time, O(n) on nearly sorted input, with O(1) extra space,
+// and keeps equal values in their original order.
+//
+// Reference: https://en.wikipedia.org/wiki/Insertion_sort
+package insertionsort
+
+import (
+	"github.com/TranThang-2804/golangds/list"
+	"github.com/TranThang-2804/golangds/sort/observe"
+)
+
+// held is the one-value buffer holding the value being inserted
+const held observe.Array = 1
+
+// Sort sorts the values in place by shifting every value left past the
+// greater values before it
+func Sort[T any](values []T, comparator list.Comparator[T]) {
+	SortObserved(values, comparator, nil)
+}
+
+// SortObserved is Sort reporting its operations to the observer. The value
+// being inserted is held in a buffer of one value.
+func SortObserved[T any](values []T, comparator list.Comparator[T], observer observe.Observer) {
+	o := observe.Scope(observer)
+	if o != nil && len(values) > 1 {
+		o.Alloc(held, 1)
+	}
+	for i := 1; i < len(values); i++ {
+		value := values[i]
+		if o != nil {
+			o.Move(observe.At(i), observe.Position{Array: held})
+		}
+		j := i
+		for ; j > 0; j-- {
+			order := comparator(values[j-1], value)
+			if o != nil {
+				o.Compare(observe.At(j-1), observe.Position{Array: held}, order)
+			}
+			if order <= 0 {
+				break
+			}
+			values[j] = values[j-1]
+			if o != nil {
+				o.Move(observe.At(j-1), observe.At(j))
+			}
+		}
+		values[j] = value
+		if o != nil {
+			o.Move(observe.Position{Array: held}, observe.At(j))
+		}
+	}
+}
+
+// BinarySort sorts the values in place, finding the insertion point of every
+// value with a binary search. It makes O(n log n) comparisons but still
+// O(n^2) moves.
+func BinarySort[T any](values []T, comparator list.Comparator[T]) {
+	BinarySortObserved(values, comparator, nil)
+}
+
+// BinarySortObserved is BinarySort reporting its operations to the observer.
+// The value being inserted is held in a buffer of one value.
+func BinarySortObserved[T any](values []T, comparator list.Comparator[T], observer observe.Observer) {
+	o := observe.Scope(observer)
+	if o != nil && len(values) > 1 {
+		o.Alloc(held, 1)
+	}
+	for i := 1; i < len(values); i++ {
+		value := values[i]
+		// Insert after the equal values to stay stable
+		low, high := 0, i
+		for low < high {
+			middle := int(uint(low+high) >> 1)
+			order := comparator(value, values[middle])
+			if o != nil {
+				o.Compare(observe.At(i), observe.At(middle), order)
+			}
+			if order < 0 {
+				high = middle
+			} else {
+				low = middle + 1
+			}
+		}
+		if low == i {
+			continue
+		}
+
+		copy(values[low+1:i+1], values[low:i])
+		values[low] = value
+		if o != nil {
+			o.Move(observe.At(i), observe.Position{Array: held})
+			for j := i; j > low; j-- {
+				o.Move(observe.At(j-1), observe.At(j))
+			}
+			o.Move(observe.Position{Array: held}, observe.At(low))
+		}
+	}
+}
@@ -0,0 +1,39 @@
+package insertionsort
+
+import (
+	"testing"
+
+	"github.com/TranThang-2804/golangds/sort/internal/sorttest"
+)
+
+func TestSort(t *testing.T) {
+	sorttest.Run(t, Sort[sorttest.Item], true)
+}
+
+func TestBinarySort(t *testing.T) {
+	sorttest.Run(t, BinarySort[sorttest.Item], true)
+}
+
+func TestSortObserved(t *testing.T) {
+	sorttest.RunObserved(t, SortObserved[sorttest.Item], true)
+}
+
+func TestBinarySortObserved(t *testing.T) {
+	sorttest.RunObserved(t, BinarySortObserved[sorttest.Item], true)
+}
+
+func TestBinarySortComparisons(t *testing.T) {
+	values := make([]int, 256)
+	for i := range values {
+		values[i] = len(values) - i
+	}
+	comparisons := 0
+	BinarySort(values, func(a, b int) int {
+		comparisons++
+		return a - b
+	})
+	// At most ceil(log2(i+1)) comparisons to insert the value at index i
+	if actualValue, expectedValue := comparisons, 256*8; actualValue > expectedValue {
+		t.Errorf("Got %v expected at most %v", actualValue, expectedValue)
+	}
+}
@@ -0,0 +1,203 @@
+// Package sorttest is a test suite shared by the sorting packages.
+//
+// Every algorithm is run over the same inputs and checked for sortedness,
+// permutation-preservation and, for stable algorithms, stability.
+package sorttest
+
+import (
+	"cmp"
+	"fmt"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/TranThang-2804/golangds/list"
+	"github.com/TranThang-2804/golangds/sort/observe"
+)
+
+// Item is a value sorted by Key only, Order records its original position
+// so that stability can be checked
+type Item struct {
+	Key   int
+	Order int
+}
+
+// Compare orders items by key
+func Compare(a, b Item) int {
+	return cmp.Compare(a.Key, b.Key)
+}
+
+// Sorter is the signature shared by the sorting functions
+type Sorter func(values []Item, comparator list.Comparator[Item])
+
+// ObservedSorter is the signature shared by the instrumented sorting functions
+type ObservedSorter func(values []Item, comparator list.Comparator[Item], observer observe.Observer)
+
+// Input is a named input to sort
+type Input struct {
+	Name   string
+	Values []Item
+}
+
+// Inputs returns the inputs every algorithm is checked against, from empty
+// and tiny slices to large ones with many duplicates, sorted or reversed runs
+func Inputs() []Input {
+	random := rand.New(rand.NewSource(1))
+	inputs := []Input{
+		{Name: "empty", Values: []Item{}},
+		{Name: "nil"},
+		{Name: "single", Values: items([]int{1})},
+		{Name: "pair", Values: items([]int{2, 1})},
+		{Name: "equal pair", Values: items([]int{1, 1})},
+	}
+
+	for _, n := range []int{7, 100, 1000} {
+		keys := make([]int, n)
+		for i := range keys {
+			keys[i] = random.Intn(n)
+		}
+		inputs = append(inputs, Input{Name: fmt.Sprintf("random %d", n), Values: items(keys)})
+
+		for i := range keys {
+			keys[i] = random.Intn(4)
+		}
+		inputs = append(inputs, Input{Name: fmt.Sprintf("duplicates %d", n), Values: items(keys)})
+
+		for i := range keys {
+			keys[i] = i / 3
+		}
+		inputs = append(inputs, Input{Name: fmt.Sprintf("sorted %d", n), Values: items(keys)})
+
+		for i := range keys {
+			keys[i] = (n - i) / 3
+		}
+		inputs = append(inputs, Input{Name: fmt.Sprintf("reversed %d", n), Values: items(keys)})
+
+		for i := range keys {
+			keys[i] = 0
+		}
+		inputs = append(inputs, Input{Name: fmt.Sprintf("constant %d", n), Values: items(keys)})
+
+		for i := range keys {
+			keys[i] = min(i, n-i)
+		}
+		inputs = append(inputs, Input{Name: fmt.Sprintf("organ pipe %d", n), Values: items(keys)})
+	}
+	return inputs
+}
+
+// Run sorts a copy of every input and checks the result
+func Run(t *testing.T, sort Sorter, stable bool) {
+	t.Helper()
+	for _, input := range Inputs() {
+		values := slices.Clone(input.Values)
+		sort(values, Compare)
+		Check(t, input.Name, input.Values, values, stable)
+	}
+}
+
+// RunObserved sorts a copy of every input while recording its trace and
+// counting its operations, then checks the result and the trace
+func RunObserved(t *testing.T, sort ObservedSorter, stable bool) {
+	t.Helper()
+	for _, input := range Inputs() {
+		values := slices.Clone(input.Values)
+		trace, counter := observe.NewTrace(), observe.NewCounter()
+		sort(values, Compare, observe.Multi(trace, counter))
+		Check(t, input.Name, input.Values, values, stable)
+		CheckTrace(t, input.Name, input.Values, values, trace)
+
+		comparisons, swaps, moves := 0, 0, 0
+		for _, event := range trace.Events() {
+			switch event.Kind {
+			case observe.CompareEvent:
+				comparisons++
+			case observe.SwapEvent:
+				swaps++
+			case observe.MoveEvent:
+				moves++
+			}
+		}
+		if actualValue, expectedValue := counter.Comparisons(), int64(comparisons); actualValue != expectedValue {
+			t.Errorf("%s: Got %v expected %v comparisons", input.Name, actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := counter.Swaps(), int64(swaps); actualValue != expectedValue {
+			t.Errorf("%s: Got %v expected %v swaps", input.Name, actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := counter.Moves(), int64(moves); actualValue != expectedValue {
+			t.Errorf("%s: Got %v expected %v moves", input.Name, actualValue, expectedValue)
+		}
+	}
+}
+
+// CheckTrace reports an error if replaying the trace on the input does not
+// produce the sorted values, or if a comparison it recorded does not match
+// the values at the compared positions when it is replayed
+func CheckTrace(t *testing.T, name string, input, sorted []Item, trace *observe.Trace) {
+	t.Helper()
+	replayer := observe.NewReplayer(trace, input)
+	for event, ok := replayer.Step(); ok; event, ok = replayer.Step() {
+		if event.Kind != observe.CompareEvent {
+			continue
+		}
+		a, aOk := replayer.Array(event.A.Array)
+		b, bOk := replayer.Array(event.B.Array)
+		if !aOk || !bOk {
+			t.Errorf("%s: Got %v on an array not allocated", name, event)
+			return
+		}
+		if actualValue, expectedValue := sign(event.Result), sign(Compare(a[event.A.Index], b[event.B.Index])); actualValue != expectedValue {
+			t.Errorf("%s: Got %v expected %v for %v", name, actualValue, expectedValue, event)
+			return
+		}
+	}
+	if actualValue, expectedValue := replayer.Values(), sorted; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("%s: Got %v expected %v after replaying", name, actualValue, expectedValue)
+	}
+}
+
+func sign(order int) int {
+	return cmp.Compare(order, 0)
+}
+
+// Check reports an error if the sorted values are out of order, are not a
+// permutation of the input or, when stable, reorder equal items
+func Check(t *testing.T, name string, input, sorted []Item, stable bool) {
+	t.Helper()
+	if actualValue, expectedValue := len(sorted), len(input); actualValue != expectedValue {
+		t.Errorf("%s: Got %v expected %v", name, actualValue, expectedValue)
+		return
+	}
+
+	count := make(map[Item]int, len(input))
+	for _, item := range input {
+		count[item]++
+	}
+	for _, item := range sorted {
+		count[item]--
+		if count[item] < 0 {
+			t.Errorf("%s: Got %v which is not in the input", name, item)
+			return
+		}
+	}
+
+	for i := 1; i < len(sorted); i++ {
+		previous, current := sorted[i-1], sorted[i]
+		if previous.Key > current.Key {
+			t.Errorf("%s: Got %v before %v at index %d", name, previous, current, i)
+			return
+		}
+		if stable && previous.Key == current.Key && previous.Order > current.Order {
+			t.Errorf("%s: Got %v before %v at index %d, order of equal items changed", name, previous, current, i)
+			return
+		}
+	}
+}
+
+func items(keys []int) []Item {
+	values := make([]Item, len(keys))
+	for i, key := range keys {
+		values[i] = Item{Key: key, Order: i}
+	}
+	return values
+}
@@ -0,0 +1,149 @@
+// Package mergesort implements stable merge sorts of slices.
+//
+// Merge sort splits the values into runs, sorts them and merges sorted runs
+// pairwise. Both variants run in O(n log n) time with an O(n) buffer and
+// keep equal values in their original order.
+//
+// Reference: https://en.wikipedia.org/wiki/Merge_sort
+package mergesort
+
+import (
+	"github.com/TranThang-2804/golangds/list"
+	"github.com/TranThang-2804/golangds/sort/observe"
+)
+
+// buffer is the array of the merge buffer
+const buffer observe.Array = 1
+
+// TopDown sorts the values in place by recursively sorting both halves and
+// merging them
+func TopDown[T any](values []T, comparator list.Comparator[T]) {
+	TopDownObserved(values, comparator, nil)
+}
+
+// TopDownObserved is TopDown reporting its operations to the observer
+func TopDownObserved[T any](values []T, comparator list.Comparator[T], observer observe.Observer) {
+	if len(values) < 2 {
+		return
+	}
+	s := &sorter[T]{comparator: comparator, observer: observe.Scope(observer)}
+	s.arrays[observe.Values] = values
+	s.arrays[buffer] = s.alloc(len(values))
+	s.topDown(0, len(values))
+}
+
+// topDown sorts values[low:high]
+func (s *sorter[T]) topDown(low, high int) {
+	if high-low < 2 {
+		return
+	}
+	middle := low + (high-low)/2
+	s.topDown(low, middle)
+	s.topDown(middle, high)
+
+	// Already in order, nothing to merge
+	if s.compare(observe.At(middle-1), observe.At(middle)) <= 0 {
+		return
+	}
+	copy(s.arrays[buffer][low:high], s.arrays[observe.Values][low:high])
+	for i := low; i < high; i++ {
+		s.moved(observe.Values, i, buffer, i)
+	}
+	s.merge(buffer, observe.Values, low, middle, high)
+}
+
+// BottomUp sorts the values in place by merging runs of width 1, 2, 4, ...
+// without recursion
+func BottomUp[T any](values []T, comparator list.Comparator[T]) {
+	BottomUpObserved(values, comparator, nil)
+}
+
+// BottomUpObserved is BottomUp reporting its operations to the observer
+func BottomUpObserved[T any](values []T, comparator list.Comparator[T], observer observe.Observer) {
+	if len(values) < 2 {
+		return
+	}
+	s := &sorter[T]{comparator: comparator, observer: observe.Scope(observer)}
+	s.arrays[observe.Values] = values
+	s.arrays[buffer] = s.alloc(len(values))
+
+	// Merge back and forth between the values and the buffer
+	source, target := observe.Values, buffer
+	for width := 1; width < len(values); width *= 2 {
+		for low := 0; low < len(values); low += 2 * width {
+			middle := min(low+width, len(values))
+			high := min(low+2*width, len(values))
+			s.merge(source, target, low, middle, high)
+		}
+		source, target = target, source
+	}
+	if source != observe.Values {
+		copy(values, s.arrays[buffer])
+		for i := range values {
+			s.moved(buffer, i, observe.Values, i)
+		}
+	}
+}
+
+type sorter[T any] struct {
+	comparator list.Comparator[T]
+	observer   observe.Observer
+	// arrays holds the values and the buffer
+	arrays [2][]T
+}
+
+func (s *sorter[T]) alloc(size int) []T {
+	if s.observer != nil {
+		s.observer.Alloc(buffer, size)
+	}
+	return make([]T, size)
+}
+
+func (s *sorter[T]) compare(a, b observe.Position) int {
+	order := s.comparator(s.arrays[a.Array][a.Index], s.arrays[b.Array][b.Index])
+	if s.observer != nil {
+		s.observer.Compare(a, b, order)
+	}
+	return order
+}
+
+// merge merges the sorted runs source[low:middle] and source[middle:high]
+// into target[low:high], taking from the left run on ties so that the merge
+// is stable
+func (s *sorter[T]) merge(source, target observe.Array, low, middle, high int) {
+	from, to := s.arrays[source], s.arrays[target]
+	i, j, k := low, middle, low
+	for i < middle && j < high {
+		order := s.comparator(from[j], from[i])
+		if s.observer != nil {
+			s.observer.Compare(observe.Position{Array: source, Index: j}, observe.Position{Array: source, Index: i}, order)
+		}
+		if order < 0 {
+			to[k] = from[j]
+			s.moved(source, j, target, k)
+			j++
+		} else {
+			to[k] = from[i]
+			s.moved(source, i, target, k)
+			i++
+		}
+		k++
+	}
+	copy(to[k:], from[i:middle])
+	copy(to[k+middle-i:high], from[j:high])
+	if s.observer != nil {
+		for ; i < middle; i, k = i+1, k+1 {
+			s.moved(source, i, target, k)
+		}
+		for ; j < high; j, k = j+1, k+1 {
+			s.moved(source, j, target, k)
+		}
+	}
+}
+
+// moved reports that from[i] was copied to to[j]
+func (s *sorter[T]) moved(from observe.Array, i int, to observe.Array, j int) {
+	if s.observer != nil {
+		s.observer.Move(observe.Position{Array: from, Index: i}, observe.Position{Array: to, Index: j})
+	}
+}
@@ -0,0 +1,72 @@
+package mergesort
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/TranThang-2804/golangds/list"
+	"github.com/TranThang-2804/golangds/sort/internal/sorttest"
+)
+
+func TestTopDown(t *testing.T) {
+	sorttest.Run(t, TopDown[sorttest.Item], true)
+}
+
+func TestBottomUp(t *testing.T) {
+	sorttest.Run(t, BottomUp[sorttest.Item], true)
+}
+
+func TestTopDownObserved(t *testing.T) {
+	sorttest.RunObserved(t, TopDownObserved[sorttest.Item], true)
+}
+
+func TestBottomUpObserved(t *testing.T) {
+	sorttest.RunObserved(t, BottomUpObserved[sorttest.Item], true)
+}
+
+func TestBottomUpOddWidths(t *testing.T) {
+	// Lengths just past a power of two leave a lone run for the last pass
+	for _, n := range []int{3, 5, 9, 17, 33, 65} {
+		values := make([]int, n)
+		for i := range values {
+			values[i] = n - i
+		}
+		BottomUp(values, func(a, b int) int { return a - b })
+		for i := range values {
+			if actualValue, expectedValue := values[i], i+1; actualValue != expectedValue {
+				t.Fatalf("Got %v expected %v", actualValue, expectedValue)
+			}
+		}
+	}
+}
+
+func TestNonComparable(t *testing.T) {
+	for name, sort := range map[string]func([][]int, list.Comparator[[]int]){"TopDown": TopDown[[]int], "BottomUp": BottomUp[[]int]} {
+		values := [][]int{{3}, {1, 2}, {1}, {}}
+		sort(values, slices.Compare[[]int])
+		expected := [][]int{{}, {1}, {1, 2}, {3}}
+		if !slices.EqualFunc(values, expected, slices.Equal[[]int]) {
+			t.Errorf("%s: Got %v expected %v", name, values, expected)
+		}
+	}
+}
+
+func BenchmarkTopDown(b *testing.B) {
+	benchmark(b, TopDown[sorttest.Item])
+}
+
+func BenchmarkBottomUp(b *testing.B) {
+	benchmark(b, BottomUp[sorttest.Item])
+}
+
+func benchmark(b *testing.B, sort sorttest.Sorter) {
+	input := sorttest.Inputs()
+	values := make([]sorttest.Item, 0, 1000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, in := range input {
+			values = append(values[:0], in.Values...)
+			sort(values, sorttest.Compare)
+		}
+	}
+}
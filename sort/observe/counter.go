@@ -0,0 +1,82 @@
+package observe
+
+import (
+	"fmt"
+	"sync/atomic"
+)
+
+// Counter is an observer that counts the operations of a sort. It is safe
+// for concurrent use.
+type Counter struct {
+	comparisons atomic.Int64
+	swaps       atomic.Int64
+	moves       atomic.Int64
+	allocations atomic.Int64
+	allocated   atomic.Int64
+}
+
+// NewCounter creates a counter with every count at zero
+func NewCounter() *Counter {
+	return &Counter{}
+}
+
+// Compare counts a comparison
+func (c *Counter) Compare(Position, Position, int) {
+	c.comparisons.Add(1)
+}
+
+// Swap counts a swap
+func (c *Counter) Swap(Position, Position) {
+	c.swaps.Add(1)
+}
+
+// Move counts a move
+func (c *Counter) Move(Position, Position) {
+	c.moves.Add(1)
+}
+
+// Alloc counts an allocation and its size
+func (c *Counter) Alloc(_ Array, size int) {
+	c.allocations.Add(1)
+	c.allocated.Add(int64(size))
+}
+
+// Comparisons returns the number of comparisons
+func (c *Counter) Comparisons() int64 {
+	return c.comparisons.Load()
+}
+
+// Swaps returns the number of swaps
+func (c *Counter) Swaps() int64 {
+	return c.swaps.Load()
+}
+
+// Moves returns the number of moves
+func (c *Counter) Moves() int64 {
+	return c.moves.Load()
+}
+
+// Allocations returns the number of buffers allocated
+func (c *Counter) Allocations() int64 {
+	return c.allocations.Load()
+}
+
+// Allocated returns the total number of values of the buffers allocated
+func (c *Counter) Allocated() int64 {
+	return c.allocated.Load()
+}
+
+// Reset sets every count back to zero
+func (c *Counter) Reset() {
+	c.comparisons.Store(0)
+	c.swaps.Store(0)
+	c.moves.Store(0)
+	c.allocations.Store(0)
+	c.allocated.Store(0)
+}
+
+// String returns the counts
+func (c *Counter) String() string {
+	return fmt.Sprintf("Counter\ncomparisons: %d, swaps: %d, moves: %d, allocations: %d, allocated: %d",
+		c.Comparisons(), c.Swaps(), c.Moves(), c.Allocations(), c.Allocated())
+}
@@ -0,0 +1,151 @@
+// Package observe instruments the sorting algorithms under sort/.
+//
+// Every algorithm has a variant that takes an Observer and reports to it the
+// operations it performs on the values: comparisons, swaps, moves of single
+// values and allocations of buffers. A Counter totals them, for example to
+// check that a change does not make an algorithm compare more, and a Trace
+// records them so that a Replayer can redo the sort step by step, for
+// example to render an animation.
+//
+// Positions name the slice being sorted as the Values array and the buffers
+// by the number given in their Alloc event. The events of a sort replayed in
+// order on a copy of its input turn it into the sorted output.
+package observe
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// Array identifies the slice a position refers to
+type Array int32
+
+// Values is the array of the values being sorted, buffers are numbered from 1
+const Values Array = 0
+
+// Position is an index in an array
+type Position struct {
+	Array Array
+	Index int
+}
+
+// At returns the position at the index of the values being sorted
+func At(index int) Position {
+	return Position{Array: Values, Index: index}
+}
+
+// Observer receives the operations of a sort once they are done. The
+// parallel sorts call it from several goroutines at once.
+type Observer interface {
+	// Compare reports that the values at a and b were compared
+	// and the comparator returned the result
+	Compare(a, b Position, result int)
+	// Swap reports that the values at a and b were exchanged
+	Swap(a, b Position)
+	// Move reports that the value at from was copied to to
+	Move(from, to Position)
+	// Alloc reports that a buffer of size zero values was allocated
+	Alloc(array Array, size int)
+}
+
+// Scope prepares an observer for a sort. An algorithm calls it on the
+// observer it was given before reporting anything, so that the buffers of
+// every algorithm running within the same sort get different numbers.
+// It returns nil for a nil observer.
+func Scope(observer Observer) Observer {
+	switch o := observer.(type) {
+	case nil:
+		return nil
+	case *scope:
+		return o
+	default:
+		return &scope{observer: o, arrays: new(atomic.Int32), base: At(0)}
+	}
+}
+
+// Slice returns the observer of a sort that an algorithm runs on part of an
+// array, starting at the position. The events of that sort are reported in
+// the coordinates of the observer it is derived from, with buffers of its own.
+// It returns nil for a nil observer.
+func Slice(observer Observer, from Position) Observer {
+	if observer == nil {
+		return nil
+	}
+	parent := Scope(observer).(*scope)
+	return &scope{observer: parent.observer, arrays: parent.arrays, base: parent.global(from)}
+}
+
+// scope translates the positions of one algorithm into those of the sort
+type scope struct {
+	observer Observer
+	// arrays numbers the buffers of the whole sort
+	arrays *atomic.Int32
+	// base is the position of the first value of this algorithm
+	base Position
+
+	lock    sync.RWMutex
+	buffers map[Array]Array
+}
+
+func (s *scope) global(p Position) Position {
+	if p.Array == Values {
+		return Position{Array: s.base.Array, Index: s.base.Index + p.Index}
+	}
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return Position{Array: s.buffers[p.Array], Index: p.Index}
+}
+
+func (s *scope) Compare(a, b Position, result int) {
+	s.observer.Compare(s.global(a), s.global(b), result)
+}
+
+func (s *scope) Swap(a, b Position) {
+	s.observer.Swap(s.global(a), s.global(b))
+}
+
+func (s *scope) Move(from, to Position) {
+	s.observer.Move(s.global(from), s.global(to))
+}
+
+func (s *scope) Alloc(array Array, size int) {
+	global := Array(s.arrays.Add(1))
+	s.lock.Lock()
+	if s.buffers == nil {
+		s.buffers = make(map[Array]Array)
+	}
+	s.buffers[array] = global
+	s.lock.Unlock()
+	s.observer.Alloc(global, size)
+}
+
+// Multi returns an observer that forwards every event to all the observers
+func Multi(observers ...Observer) Observer {
+	return multi(observers)
+}
+
+type multi []Observer
+
+func (m multi) Compare(a, b Position, result int) {
+	for _, o := range m {
+		o.Compare(a, b, result)
+	}
+}
+
+func (m multi) Swap(a, b Position) {
+	for _, o := range m {
+		o.Swap(a, b)
+	}
+}
+
+func (m multi) Move(from, to Position) {
+	for _, o := range m {
+		o.Move(from, to)
+	}
+}
+
+func (m multi) Alloc(array Array, size int) {
+	for _, o := range m {
+		o.Alloc(array, size)
+	}
+}
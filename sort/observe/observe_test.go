@@ -0,0 +1,179 @@
+package observe
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestCounter(t *testing.T) {
+	c := NewCounter()
+	c.Compare(At(0), At(1), -1)
+	c.Compare(At(1), At(2), 1)
+	c.Swap(At(0), At(1))
+	c.Alloc(1, 10)
+	c.Alloc(2, 5)
+	c.Move(At(0), Position{Array: 1})
+	for _, count := range []struct{ actualValue, expectedValue int64 }{
+		{c.Comparisons(), 2},
+		{c.Swaps(), 1},
+		{c.Moves(), 1},
+		{c.Allocations(), 2},
+		{c.Allocated(), 15},
+	} {
+		if count.actualValue != count.expectedValue {
+			t.Errorf("Got %v expected %v", count.actualValue, count.expectedValue)
+		}
+	}
+	if actualValue, expectedValue := c.String(), "Counter\ncomparisons: 2, swaps: 1, moves: 1, allocations: 2, allocated: 15"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	c.Reset()
+	if actualValue, expectedValue := c.Comparisons()+c.Swaps()+c.Moves()+c.Allocations()+c.Allocated(), int64(0); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestCounterConcurrent(t *testing.T) {
+	c := NewCounter()
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 1000; j++ {
+				c.Compare(At(0), At(1), 0)
+			}
+		}()
+	}
+	wg.Wait()
+	if actualValue, expectedValue := c.Comparisons(), int64(8000); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestTrace(t *testing.T) {
+	trace := NewTrace()
+	if actualValue, expectedValue := trace.IsEmpty(), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	trace.Compare(At(0), At(1), 1)
+	trace.Swap(At(0), At(1))
+	trace.Alloc(1, 2)
+	trace.Move(At(1), Position{Array: 1, Index: 0})
+	if actualValue, expectedValue := trace.GetSize(), 4; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	expected := "Trace\nCompare {0 0} {0 1} = 1\nSwap {0 0} {0 1}\nAlloc 1 [2]\nMove {0 1} {1 0}"
+	if actualValue, expectedValue := trace.String(), expected; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// Events returns a copy
+	events := trace.Events()
+	events[0].Result = -1
+	if actualValue, expectedValue := trace.Events()[0].Result, 1; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	trace.Clear()
+	if actualValue, expectedValue := trace.IsEmpty(), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestReplayer(t *testing.T) {
+	input := []int{3, 1, 2}
+	trace := NewTrace()
+	// Insert 1 before 3 through a buffer, then swap 3 and 2
+	trace.Alloc(1, 1)
+	trace.Move(At(1), Position{Array: 1})
+	trace.Move(At(0), At(1))
+	trace.Move(Position{Array: 1}, At(0))
+	trace.Swap(At(1), At(2))
+
+	r := NewReplayer(trace, input)
+	if _, ok := r.Array(1); ok {
+		t.Errorf("Got %v expected %v", ok, false)
+	}
+	event, ok := r.Step()
+	if !ok || event.Kind != AllocEvent {
+		t.Errorf("Got %v expected %v", event.Kind, AllocEvent)
+	}
+	r.Step()
+	if buffer, ok := r.Array(1); !ok || buffer[0] != 1 {
+		t.Errorf("Got %v expected %v", buffer, []int{1})
+	}
+	values := r.Replay()
+	for i, expectedValue := range []int{1, 2, 3} {
+		if actualValue := values[i]; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+	if actualValue, expectedValue := r.Done(), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if _, ok := r.Step(); ok {
+		t.Errorf("Got %v expected %v", ok, false)
+	}
+	// The input is left untouched
+	if actualValue, expectedValue := input[0], 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestScope(t *testing.T) {
+	if actualValue := Scope(nil); actualValue != nil {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	if actualValue := Slice(nil, At(1)); actualValue != nil {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+
+	trace := NewTrace()
+	root := Scope(trace)
+	if actualValue, expectedValue := Scope(root), root; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	root.Alloc(1, 10)
+
+	// A slice from index 4 of the root buffer with a buffer of its own
+	child := Slice(root, Position{Array: 1, Index: 4})
+	child.Alloc(1, 2)
+	child.Move(At(1), Position{Array: 1, Index: 0})
+	// A nested slice of the values of the child
+	grandchild := Slice(child, At(2))
+	grandchild.Compare(At(0), At(1), 0)
+	root.Swap(At(0), Position{Array: 1, Index: 0})
+
+	expected := []Event{
+		{Kind: AllocEvent, A: Position{Array: 1}, Size: 10},
+		{Kind: AllocEvent, A: Position{Array: 2}, Size: 2},
+		{Kind: MoveEvent, A: Position{Array: 1, Index: 5}, B: Position{Array: 2, Index: 0}},
+		{Kind: CompareEvent, A: Position{Array: 1, Index: 6}, B: Position{Array: 1, Index: 7}},
+		{Kind: SwapEvent, A: At(0), B: Position{Array: 1, Index: 0}},
+	}
+	events := trace.Events()
+	if actualValue, expectedValue := len(events), len(expected); actualValue != expectedValue {
+		t.Fatalf("Got %v expected %v", actualValue, expectedValue)
+	}
+	for i := range expected {
+		if actualValue, expectedValue := events[i], expected[i]; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+}
+
+func TestMulti(t *testing.T) {
+	trace, counter := NewTrace(), NewCounter()
+	o := Multi(trace, counter)
+	o.Compare(At(0), At(1), 0)
+	o.Swap(At(0), At(1))
+	o.Move(At(0), At(1))
+	o.Alloc(1, 3)
+	if actualValue, expectedValue := trace.GetSize(), 4; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := counter.Comparisons()+counter.Swaps()+counter.Moves()+counter.Allocations(), int64(4); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
@@ -0,0 +1,188 @@
+package observe
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// Kind is the kind of operation of an event
+type Kind int
+
+const (
+	CompareEvent Kind = iota
+	SwapEvent
+	MoveEvent
+	AllocEvent
+)
+
+func (k Kind) String() string {
+	switch k {
+	case CompareEvent:
+		return "Compare"
+	case SwapEvent:
+		return "Swap"
+	case MoveEvent:
+		return "Move"
+	case AllocEvent:
+		return "Alloc"
+	}
+	return fmt.Sprintf("Kind(%d)", int(k))
+}
+
+// Event is an operation recorded by a Trace
+type Event struct {
+	Kind Kind
+	// A and B are the compared or swapped positions, or the source and the
+	// destination of a move. An allocation only sets A.Array.
+	A, B Position
+	// Result is the result of a comparison
+	Result int
+	// Size is the size of an allocation
+	Size int
+}
+
+func (e Event) String() string {
+	switch e.Kind {
+	case CompareEvent:
+		return fmt.Sprintf("Compare %v %v = %d", e.A, e.B, e.Result)
+	case AllocEvent:
+		return fmt.Sprintf("Alloc %d [%d]", e.A.Array, e.Size)
+	}
+	return fmt.Sprintf("%v %v %v", e.Kind, e.A, e.B)
+}
+
+// Trace is an observer that records the events of a sort in order. It is
+// safe for concurrent use.
+type Trace struct {
+	lock   sync.Mutex
+	events []Event
+}
+
+// NewTrace creates an empty trace
+func NewTrace() *Trace {
+	return &Trace{}
+}
+
+func (t *Trace) record(event Event) {
+	t.lock.Lock()
+	t.events = append(t.events, event)
+	t.lock.Unlock()
+}
+
+// Compare records a comparison
+func (t *Trace) Compare(a, b Position, result int) {
+	t.record(Event{Kind: CompareEvent, A: a, B: b, Result: result})
+}
+
+// Swap records a swap
+func (t *Trace) Swap(a, b Position) {
+	t.record(Event{Kind: SwapEvent, A: a, B: b})
+}
+
+// Move records a move
+func (t *Trace) Move(from, to Position) {
+	t.record(Event{Kind: MoveEvent, A: from, B: to})
+}
+
+// Alloc records an allocation
+func (t *Trace) Alloc(array Array, size int) {
+	t.record(Event{Kind: AllocEvent, A: Position{Array: array}, Size: size})
+}
+
+// Events returns a copy of the events recorded
+func (t *Trace) Events() []Event {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	events := make([]Event, len(t.events))
+	copy(events, t.events)
+	return events
+}
+
+// Get the number of events recorded
+func (t *Trace) GetSize() int {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	return len(t.events)
+}
+
+// Check if no event was recorded
+func (t *Trace) IsEmpty() bool {
+	return t.GetSize() == 0
+}
+
+// Clear drops the events recorded
+func (t *Trace) Clear() {
+	t.lock.Lock()
+	t.events = nil
+	t.lock.Unlock()
+}
+
+// String returns the events, one per line
+func (t *Trace) String() string {
+	str := []string{"Trace"}
+	for _, event := range t.Events() {
+		str = append(str, event.String())
+	}
+	return strings.Join(str, "\n")
+}
+
+// Replayer redoes a recorded sort on a copy of its input one event at a time
+type Replayer[T any] struct {
+	events []Event
+	next   int
+	arrays map[Array][]T
+}
+
+// NewReplayer creates a replayer of the trace, starting from a copy of the
+// input the trace was recorded on
+func NewReplayer[T any](trace *Trace, input []T) *Replayer[T] {
+	values := make([]T, len(input))
+	copy(values, input)
+	return &Replayer[T]{events: trace.Events(), arrays: map[Array][]T{Values: values}}
+}
+
+// Step applies the next event and returns it
+// return false if every event was replayed
+func (r *Replayer[T]) Step() (Event, bool) {
+	if r.next >= len(r.events) {
+		return Event{}, false
+	}
+	event := r.events[r.next]
+	r.next++
+
+	switch event.Kind {
+	case SwapEvent:
+		a, b := r.arrays[event.A.Array], r.arrays[event.B.Array]
+		a[event.A.Index], b[event.B.Index] = b[event.B.Index], a[event.A.Index]
+	case MoveEvent:
+		r.arrays[event.B.Array][event.B.Index] = r.arrays[event.A.Array][event.A.Index]
+	case AllocEvent:
+		r.arrays[event.A.Array] = make([]T, event.Size)
+	}
+	return event, true
+}
+
+// Replay applies every remaining event and returns the values
+func (r *Replayer[T]) Replay() []T {
+	for _, ok := r.Step(); ok; _, ok = r.Step() {
+	}
+	return r.Values()
+}
+
+// Values returns the values in their current order
+func (r *Replayer[T]) Values() []T {
+	return r.arrays[Values]
+}
+
+// Array returns the current content of an array
+// return false if the array was not allocated yet
+func (r *Replayer[T]) Array(array Array) ([]T, bool) {
+	values, ok := r.arrays[array]
+	return values, ok
+}
+
+// Done checks if every event was replayed
+func (r *Replayer[T]) Done() bool {
+	return r.next >= len(r.events)
+}
@@ -0,0 +1,371 @@
+// Package parallelsort implements merge sort and quick sort of slices spread
+// over several goroutines.
+//
+// Both sorts split the slice recursively and hand one side of every split to
+// another goroutine while workers are available, sorting runs shorter than
+// the cutoff sequentially. The result only depends on the input, never on
+// scheduling: MergeSort is stable, QuickSort is not but picks its pivots
+// deterministically.
+//
+// Reference: https://en.wikipedia.org/wiki/Merge_sort#Parallel_merge_sort
+package parallelsort
+
+import (
+	"runtime"
+	"sync"
+
+	"github.com/TranThang-2804/golangds/list"
+	"github.com/TranThang-2804/golangds/sort/heapsort"
+	"github.com/TranThang-2804/golangds/sort/mergesort"
+	"github.com/TranThang-2804/golangds/sort/observe"
+	"github.com/TranThang-2804/golangds/sort/quicksort"
+)
+
+// DefaultCutoff is the run length under which the sorts stop splitting
+// when Options.Cutoff is not set
+const DefaultCutoff = 8192
+
+// Options tunes the parallel sorts
+type Options struct {
+	// Workers is the maximum number of goroutines sorting at once,
+	// runtime.GOMAXPROCS(0) if not positive
+	Workers int
+	// Cutoff is the run length under which runs are sorted sequentially,
+	// DefaultCutoff if not positive
+	Cutoff int
+	// Observer, if not nil, is reported the operations of the sort from
+	// every goroutine at once
+	Observer observe.Observer
+}
+
+func (o Options) withDefaults() Options {
+	if o.Workers <= 0 {
+		o.Workers = runtime.GOMAXPROCS(0)
+	}
+	if o.Cutoff <= 0 {
+		o.Cutoff = DefaultCutoff
+	}
+	return o
+}
+
+// pool hands work to new goroutines while fewer than its number of workers
+// are running, and runs it inline otherwise
+type pool struct {
+	tokens chan struct{}
+	cutoff int
+}
+
+func newPool(options Options) *pool {
+	options = options.withDefaults()
+	// The calling goroutine is a worker too
+	return &pool{tokens: make(chan struct{}, options.Workers-1), cutoff: options.Cutoff}
+}
+
+// run calls f on another goroutine if a worker is free, else on this one
+func (p *pool) run(wg *sync.WaitGroup, f func()) {
+	select {
+	case p.tokens <- struct{}{}:
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-p.tokens }()
+			f()
+		}()
+	default:
+		f()
+	}
+}
+
+// MergeSort sorts the values in place with a parallel merge sort on
+// runtime.GOMAXPROCS(0) goroutines, keeping equal values in their original order
+func MergeSort[T any](values []T, comparator list.Comparator[T]) {
+	MergeSortWith(values, comparator, Options{})
+}
+
+// MergeSortWith sorts the values in place with a parallel merge sort tuned by
+// the options, keeping equal values in their original order. Both the halves
+// and the merges are split across goroutines, with an O(n) buffer.
+func MergeSortWith[T any](values []T, comparator list.Comparator[T], options Options) {
+	p := newPool(options)
+	observer := observe.Scope(options.Observer)
+	if len(values) <= p.cutoff {
+		mergesort.TopDownObserved(values, comparator, observer)
+		return
+	}
+	s := &mergeSorter[T]{pool: p, comparator: comparator, observer: observer}
+	s.arrays[observe.Values] = values
+	s.arrays[buffer] = make([]T, len(values))
+	if observer != nil {
+		observer.Alloc(buffer, len(values))
+	}
+	s.sort(0, len(values), false)
+}
+
+// buffer is the array of the merge buffer
+const buffer observe.Array = 1
+
+type mergeSorter[T any] struct {
+	*pool
+	comparator list.Comparator[T]
+	observer   observe.Observer
+	// arrays holds the values and the buffer
+	arrays [2][]T
+}
+
+func (s *mergeSorter[T]) compare(a, b observe.Position) int {
+	order := s.comparator(s.arrays[a.Array][a.Index], s.arrays[b.Array][b.Index])
+	if s.observer != nil {
+		s.observer.Compare(a, b, order)
+	}
+	return order
+}
+
+func (s *mergeSorter[T]) move(from, to observe.Position) {
+	s.arrays[to.Array][to.Index] = s.arrays[from.Array][from.Index]
+	if s.observer != nil {
+		s.observer.Move(from, to)
+	}
+}
+
+// sort sorts values[low:high], then leaves a copy of them in the buffer if
+// the caller merges from there
+func (s *mergeSorter[T]) sort(low, high int, copyToBuffer bool) {
+	if high-low <= s.cutoff {
+		mergesort.TopDownObserved(s.arrays[observe.Values][low:high], s.comparator, observe.Slice(s.observer, observe.At(low)))
+	} else {
+		middle := low + (high-low)/2
+		var wg sync.WaitGroup
+		s.run(&wg, func() { s.sort(low, middle, true) })
+		s.sort(middle, high, true)
+		wg.Wait()
+		s.merge(low, run{low, middle}, run{middle, high})
+	}
+	if copyToBuffer {
+		copy(s.arrays[buffer][low:high], s.arrays[observe.Values][low:high])
+		if s.observer != nil {
+			for i := low; i < high; i++ {
+				s.observer.Move(observe.At(i), s.at(i))
+			}
+		}
+	}
+}
+
+// run is the range [low:high] of a sorted run in the buffer
+type run struct {
+	low, high int
+}
+
+func (r run) length() int {
+	return r.high - r.low
+}
+
+// merge merges the sorted left and right runs of the buffer into the values
+// from target on. The middle value of the longer run splits both runs into
+// two pairs merged independently, with equal values of left kept before
+// those of right.
+func (s *mergeSorter[T]) merge(target int, left, right run) {
+	if left.length()+right.length() <= s.cutoff {
+		s.sequentialMerge(target, left, right)
+		return
+	}
+
+	var i, j int
+	if left.length() >= right.length() {
+		i = left.low + left.length()/2
+		// Values of right equal to the pivot go after it
+		j = s.search(right, func(index int) bool { return s.compare(s.at(index), s.at(i)) >= 0 })
+		pivot := target + (i - left.low) + (j - right.low)
+		s.move(s.at(i), observe.At(pivot))
+		var wg sync.WaitGroup
+		s.run(&wg, func() { s.merge(target, run{left.low, i}, run{right.low, j}) })
+		s.merge(pivot+1, run{i + 1, left.high}, run{j, right.high})
+		wg.Wait()
+		return
+	}
+
+	j = right.low + right.length()/2
+	// Values of left equal to the pivot go before it
+	i = s.search(left, func(index int) bool { return s.compare(s.at(index), s.at(j)) > 0 })
+	pivot := target + (i - left.low) + (j - right.low)
+	s.move(s.at(j), observe.At(pivot))
+	var wg sync.WaitGroup
+	s.run(&wg, func() { s.merge(target, run{left.low, i}, run{right.low, j}) })
+	s.merge(pivot+1, run{i, left.high}, run{j + 1, right.high})
+	wg.Wait()
+}
+
+// at returns the position of the index in the buffer
+func (s *mergeSorter[T]) at(index int) observe.Position {
+	return observe.Position{Array: buffer, Index: index}
+}
+
+// search returns the index of the first value of the sorted run
+// for which found is true
+func (s *mergeSorter[T]) search(r run, found func(index int) bool) int {
+	low, high := r.low, r.high
+	for low < high {
+		middle := int(uint(low+high) >> 1)
+		if found(middle) {
+			high = middle
+		} else {
+			low = middle + 1
+		}
+	}
+	return low
+}
+
+// sequentialMerge merges the sorted left and right runs of the buffer into
+// the values from target on, taking from left on ties
+func (s *mergeSorter[T]) sequentialMerge(target int, left, right run) {
+	from, to := s.arrays[buffer], s.arrays[observe.Values]
+	i, j, k := left.low, right.low, target
+	for i < left.high && j < right.high {
+		order := s.comparator(from[j], from[i])
+		if s.observer != nil {
+			s.observer.Compare(s.at(j), s.at(i), order)
+		}
+		if order < 0 {
+			to[k] = from[j]
+			s.moved(j, k)
+			j++
+		} else {
+			to[k] = from[i]
+			s.moved(i, k)
+			i++
+		}
+		k++
+	}
+	copy(to[k:], from[i:left.high])
+	copy(to[k+left.high-i:], from[j:right.high])
+	if s.observer != nil {
+		for ; i < left.high; i, k = i+1, k+1 {
+			s.moved(i, k)
+		}
+		for ; j < right.high; j, k = j+1, k+1 {
+			s.moved(j, k)
+		}
+	}
+}
+
+// moved reports that the value at index i of the buffer was copied to index j
+// of the values
+func (s *mergeSorter[T]) moved(i, j int) {
+	if s.observer != nil {
+		s.observer.Move(s.at(i), observe.At(j))
+	}
+}
+
+// QuickSort sorts the values in place with a parallel quick sort on
+// runtime.GOMAXPROCS(0) goroutines
+func QuickSort[T any](values []T, comparator list.Comparator[T]) {
+	QuickSortWith(values, comparator, Options{})
+}
+
+// QuickSortWith sorts the values in place with a parallel quick sort tuned by
+// the options. The two sides of every partition are sorted concurrently and
+// a run that keeps partitioning badly falls back to heap sort, so it needs no
+// buffer and runs in O(n log n) time in the worst case.
+func QuickSortWith[T any](values []T, comparator list.Comparator[T], options Options) {
+	p := newPool(options)
+	s := &quickSorter[T]{pool: p, values: values, comparator: comparator, observer: observe.Scope(options.Observer)}
+
+	// Allow 2*log2(n) levels of partitioning before falling back
+	depth := 0
+	for n := len(values); n > 0; n >>= 1 {
+		depth += 2
+	}
+	var wg sync.WaitGroup
+	s.sort(&wg, 0, len(values), depth)
+	wg.Wait()
+}
+
+// held is the one-value buffer holding the pivot of a partition
+const held observe.Array = 1
+
+type quickSorter[T any] struct {
+	*pool
+	values     []T
+	comparator list.Comparator[T]
+	observer   observe.Observer
+}
+
+// sort sorts values[low:high]
+func (s *quickSorter[T]) sort(wg *sync.WaitGroup, low, high, depth int) {
+	for high-low > s.cutoff {
+		if depth == 0 {
+			heapsort.SortObserved(s.values[low:high], s.comparator, observe.Slice(s.observer, observe.At(low)))
+			return
+		}
+		depth--
+
+		less, greater := s.partition(low, high)
+		left, right := [2]int{low, less}, [2]int{greater, high}
+		// Hand the smaller side over and keep looping on the larger one
+		if left[1]-left[0] > right[1]-right[0] {
+			left, right = right, left
+		}
+		remaining := depth
+		s.run(wg, func() { s.sort(wg, left[0], left[1], remaining) })
+		low, high = right[0], right[1]
+	}
+	quicksort.SortObserved(s.values[low:high], s.comparator, observe.Slice(s.observer, observe.At(low)))
+}
+
+// partition rearranges values[low:high] around the median of the first,
+// middle and last values into values less than, equal to and greater than
+// it, and returns the bounds of the equal values
+func (s *quickSorter[T]) partition(low, high int) (int, int) {
+	// Every partition holds its pivot in a buffer of its own, partitions
+	// run concurrently
+	o := observe.Slice(s.observer, observe.At(0))
+	compare := func(i, j int) int {
+		order := s.comparator(s.values[i], s.values[j])
+		if o != nil {
+			o.Compare(observe.At(i), observe.At(j), order)
+		}
+		return order
+	}
+	a, b, c := low, low+(high-1-low)/2, high-1
+	if compare(b, a) < 0 {
+		a, b = b, a
+	}
+	if compare(c, b) < 0 {
+		b, c = c, b
+		if compare(b, a) < 0 {
+			b = a
+		}
+	}
+	pivot := s.values[b]
+	if o != nil {
+		o.Alloc(held, 1)
+		o.Move(observe.At(b), observe.Position{Array: held})
+	}
+
+	// values[low:less] < pivot, values[less:i] == pivot, values[greater:high] > pivot
+	less, i, greater := low, low, high
+	for i < greater {
+		order := s.comparator(s.values[i], pivot)
+		if o != nil {
+			o.Compare(observe.At(i), observe.Position{Array: held}, order)
+		}
+		switch {
+		case order < 0:
+			s.swap(o, less, i)
+			less++
+			i++
+		case order > 0:
+			greater--
+			s.swap(o, i, greater)
+		default:
+			i++
+		}
+	}
+	return less, greater
+}
+
+func (s *quickSorter[T]) swap(o observe.Observer, i, j int) {
+	s.values[i], s.values[j] = s.values[j], s.values[i]
+	if o != nil {
+		o.Swap(observe.At(i), observe.At(j))
+	}
+}
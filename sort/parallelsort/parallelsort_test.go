@@ -0,0 +1,178 @@
+package parallelsort
+
+import (
+	"cmp"
+	"fmt"
+	"math/rand"
+	"runtime"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/TranThang-2804/golangds/list"
+	"github.com/TranThang-2804/golangds/sort/internal/sorttest"
+	"github.com/TranThang-2804/golangds/sort/observe"
+)
+
+// small options make even the test inputs split across goroutines
+var small = Options{Workers: 4, Cutoff: 8}
+
+func TestMergeSort(t *testing.T) {
+	sorttest.Run(t, MergeSort[sorttest.Item], true)
+}
+
+func TestMergeSortWith(t *testing.T) {
+	sorttest.Run(t, func(values []sorttest.Item, comparator list.Comparator[sorttest.Item]) {
+		MergeSortWith(values, comparator, small)
+	}, true)
+}
+
+func TestQuickSort(t *testing.T) {
+	sorttest.Run(t, QuickSort[sorttest.Item], false)
+}
+
+func TestQuickSortWith(t *testing.T) {
+	sorttest.Run(t, func(values []sorttest.Item, comparator list.Comparator[sorttest.Item]) {
+		QuickSortWith(values, comparator, small)
+	}, false)
+}
+
+func TestSingleWorker(t *testing.T) {
+	single := Options{Workers: 1, Cutoff: 8}
+	sorttest.Run(t, func(values []sorttest.Item, comparator list.Comparator[sorttest.Item]) {
+		MergeSortWith(values, comparator, single)
+	}, true)
+	sorttest.Run(t, func(values []sorttest.Item, comparator list.Comparator[sorttest.Item]) {
+		QuickSortWith(values, comparator, single)
+	}, false)
+}
+
+func TestObserved(t *testing.T) {
+	sorttest.RunObserved(t, func(values []sorttest.Item, comparator list.Comparator[sorttest.Item], observer observe.Observer) {
+		options := small
+		options.Observer = observer
+		MergeSortWith(values, comparator, options)
+	}, true)
+	sorttest.RunObserved(t, func(values []sorttest.Item, comparator list.Comparator[sorttest.Item], observer observe.Observer) {
+		options := small
+		options.Observer = observer
+		QuickSortWith(values, comparator, options)
+	}, false)
+}
+
+func TestObservedHeapSortFallback(t *testing.T) {
+	// A single level of partitioning before falling back to heap sort
+	input := randomItems(1000, 10)
+	values := slices.Clone(input)
+	trace := observe.NewTrace()
+	s := &quickSorter[sorttest.Item]{pool: newPool(small), values: values, comparator: sorttest.Compare, observer: observe.Scope(trace)}
+	var wg sync.WaitGroup
+	s.sort(&wg, 0, len(values), 1)
+	wg.Wait()
+	sorttest.Check(t, "fallback", input, values, false)
+	sorttest.CheckTrace(t, "fallback", input, values, trace)
+}
+
+func randomItems(n, keys int) []sorttest.Item {
+	random := rand.New(rand.NewSource(1))
+	values := make([]sorttest.Item, n)
+	for i := range values {
+		values[i] = sorttest.Item{Key: random.Intn(keys), Order: i}
+	}
+	return values
+}
+
+func TestLarge(t *testing.T) {
+	input := randomItems(200000, 1000)
+	options := Options{Workers: 8, Cutoff: 1024}
+
+	merged := slices.Clone(input)
+	MergeSortWith(merged, sorttest.Compare, options)
+	sorttest.Check(t, "merge", input, merged, true)
+
+	quick := slices.Clone(input)
+	QuickSortWith(quick, sorttest.Compare, options)
+	sorttest.Check(t, "quick", input, quick, false)
+}
+
+func TestDeterministic(t *testing.T) {
+	input := randomItems(50000, 100)
+	expectedMerge := slices.Clone(input)
+	MergeSortWith(expectedMerge, sorttest.Compare, Options{Workers: 1, Cutoff: 64})
+	expectedQuick := slices.Clone(input)
+	QuickSortWith(expectedQuick, sorttest.Compare, Options{Workers: 1, Cutoff: 64})
+
+	for round := 0; round < 5; round++ {
+		options := Options{Workers: 2 + round*3, Cutoff: 64}
+		merged := slices.Clone(input)
+		MergeSortWith(merged, sorttest.Compare, options)
+		if !slices.Equal(merged, expectedMerge) {
+			t.Fatalf("Merge sort with %d workers gave a different result", options.Workers)
+		}
+		quick := slices.Clone(input)
+		QuickSortWith(quick, sorttest.Compare, options)
+		if !slices.Equal(quick, expectedQuick) {
+			t.Fatalf("Quick sort with %d workers gave a different result", options.Workers)
+		}
+	}
+}
+
+func TestQuickSortDegenerate(t *testing.T) {
+	// Organ pipe inputs with a tiny cutoff go through many levels of
+	// partitioning, duplicated keys included
+	for _, n := range []int{10000, 10001} {
+		values := make([]int, n)
+		for i := range values {
+			values[i] = min(i, n-i)
+		}
+		QuickSortWith(values, cmp.Compare[int], Options{Workers: 2, Cutoff: 4})
+		if !slices.IsSorted(values) {
+			t.Errorf("Got %v which is not sorted", values)
+		}
+	}
+}
+
+func randomInts(n int) []int {
+	random := rand.New(rand.NewSource(1))
+	values := make([]int, n)
+	for i := range values {
+		values[i] = random.Int()
+	}
+	return values
+}
+
+// benchmark sorts a million values with 1, 2, 4, ... up to GOMAXPROCS workers
+func benchmark(b *testing.B, sort func([]int, list.Comparator[int], Options)) {
+	input := randomInts(1000000)
+	values := make([]int, len(input))
+	for workers := 1; ; workers *= 2 {
+		workers = min(workers, runtime.GOMAXPROCS(0))
+		b.Run(fmt.Sprintf("workers=%d", workers), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				copy(values, input)
+				sort(values, cmp.Compare[int], Options{Workers: workers})
+			}
+		})
+		if workers == runtime.GOMAXPROCS(0) {
+			return
+		}
+	}
+}
+
+func BenchmarkMergeSort1000000(b *testing.B) {
+	benchmark(b, MergeSortWith[int])
+}
+
+func BenchmarkQuickSort1000000(b *testing.B) {
+	benchmark(b, QuickSortWith[int])
+}
+
+func BenchmarkSortFunc1000000(b *testing.B) {
+	input := randomInts(1000000)
+	values := make([]int, len(input))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		copy(values, input)
+		slices.SortFunc(values, cmp.Compare[int])
+	}
+}
@@ -0,0 +1,361 @@
+// Copyright 2022 The Go Authors. All rights reserved.
+//
+// This file is derived from the pdqsort of the Go standard library,
+// src/sort/zsortfunc.go, and is distributed under the following license.
+//
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are
+// met:
+//
+//    * Redistributions of source code must retain the above copyright
+// notice, this list of conditions and the following disclaimer.
+//    * Redistributions in binary form must reproduce the above
+// copyright notice, this list of conditions and the following disclaimer
+// in the documentation and/or other materials provided with the
+// distribution.
+//    * Neither the name of Google LLC nor the names of its
+// contributors may be used to endorse or promote products derived from
+// this software without specific prior written permission.
+//
+// THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+// "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+// LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+// A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+// OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+// SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+// LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+// DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+// THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+
+// Package quicksort implements pattern-defeating quick sort of slices.
+//
+// Pattern-defeating quicksort (pdqsort) is an introsort hybrid: it partitions
+// around a median-of-three or ninther pivot, insertion sorts short runs and
+// falls back to heap sort once too many partitions were unbalanced, so it
+// runs in O(n log n) time in the worst case, adversarial inputs included.
+// It recognizes sorted and reversed runs, which it sorts in O(n), shuffles a
+// few values when partitions go badly to break patterns, and partitions
+// runs of equal values in linear time. It is not stable.
+//
+// The implementation is adapted from the pdqsort of the Go standard library,
+// with a comparator over generic values and operations reported to an
+// observer.
+//
+// Reference: https://arxiv.org/pdf/2106.05123.pdf
+//
+// Reference: https://github.com/golang/go/blob/go1.22.3/src/sort/zsortfunc.go
+package quicksort
+
+import (
+	"math/bits"
+
+	"github.com/TranThang-2804/golangds/list"
+	"github.com/TranThang-2804/golangds/sort/heapsort"
+	"github.com/TranThang-2804/golangds/sort/observe"
+)
+
+// maxInsertion is the run length under which runs are insertion sorted
+const maxInsertion = 12
+
+// shortestNinther is the run length from which the pivot is the median of
+// three medians of three instead of a median of three
+const shortestNinther = 50
+
+// maxPartialSteps is the number of misplaced values partialInsertionSort
+// tries to fix before giving up
+const maxPartialSteps = 5
+
+// shortestShifting is the run length under which partialInsertionSort
+// gives up rather than shifting values
+const shortestShifting = 50
+
+// sortedHint tells how the values sampled for the pivot were ordered
+type sortedHint int
+
+const (
+	unknownHint sortedHint = iota
+	increasingHint
+	decreasingHint
+)
+
+// Sort sorts the values in place
+func Sort[T any](values []T, comparator list.Comparator[T]) {
+	SortObserved(values, comparator, nil)
+}
+
+// SortObserved is Sort reporting its operations to the observer
+func SortObserved[T any](values []T, comparator list.Comparator[T], observer observe.Observer) {
+	s := &sorter[T]{values: values, comparator: comparator, observer: observe.Scope(observer)}
+	// Allow log2(n) unbalanced partitions before falling back to heap sort
+	s.sort(0, len(values), bits.Len(uint(len(values))))
+}
+
+type sorter[T any] struct {
+	values     []T
+	comparator list.Comparator[T]
+	observer   observe.Observer
+}
+
+func (s *sorter[T]) less(i, j int) bool {
+	order := s.comparator(s.values[i], s.values[j])
+	if s.observer != nil {
+		s.observer.Compare(observe.At(i), observe.At(j), order)
+	}
+	return order < 0
+}
+
+func (s *sorter[T]) swap(i, j int) {
+	s.values[i], s.values[j] = s.values[j], s.values[i]
+	if s.observer != nil {
+		s.observer.Swap(observe.At(i), observe.At(j))
+	}
+}
+
+// sort sorts values[a:b] where limit is the number of unbalanced partitions left
+func (s *sorter[T]) sort(a, b, limit int) {
+	wasBalanced, wasPartitioned := true, true
+	for {
+		length := b - a
+		if length <= maxInsertion {
+			s.insertionSort(a, b)
+			return
+		}
+		if limit == 0 {
+			heapsort.SortObserved(s.values[a:b], s.comparator, observe.Slice(s.observer, observe.At(a)))
+			return
+		}
+		// The last partition was unbalanced, shuffle some values
+		if !wasBalanced {
+			s.breakPatterns(a, b)
+			limit--
+		}
+
+		pivot, hint := s.choosePivot(a, b)
+		if hint == decreasingHint {
+			s.reverse(a, b)
+			pivot = (b - 1) - (pivot - a)
+			hint = increasingHint
+		}
+		// The run looks sorted, try to finish it with a few moves
+		if wasBalanced && wasPartitioned && hint == increasingHint && s.partialInsertionSort(a, b) {
+			return
+		}
+
+		// The value before the run was the pivot of the parent partition.
+		// If it equals the pivot, no value of the run is smaller and the
+		// values equal to it can be put aside at once.
+		if a > 0 && !s.less(a-1, pivot) {
+			a = s.partitionEqual(a, b, pivot)
+			continue
+		}
+
+		middle, alreadyPartitioned := s.partition(a, b, pivot)
+		wasPartitioned = alreadyPartitioned
+
+		// Recurse into the smaller side and loop on the larger one
+		left, right := middle-a, b-middle
+		balanceThreshold := length / 8
+		if left < right {
+			wasBalanced = left >= balanceThreshold
+			s.sort(a, middle, limit)
+			a = middle + 1
+		} else {
+			wasBalanced = right >= balanceThreshold
+			s.sort(middle+1, b, limit)
+			b = middle
+		}
+	}
+}
+
+func (s *sorter[T]) insertionSort(a, b int) {
+	for i := a + 1; i < b; i++ {
+		for j := i; j > a && s.less(j, j-1); j-- {
+			s.swap(j, j-1)
+		}
+	}
+}
+
+// partition moves the pivot to values[a] and partitions values[a+1:b] into
+// values less than and not less than it, then puts the pivot in between and
+// returns its index. It also reports whether no value had to be moved.
+func (s *sorter[T]) partition(a, b, pivot int) (int, bool) {
+	s.swap(a, pivot)
+	i, j := a+1, b-1
+	for i <= j && s.less(i, a) {
+		i++
+	}
+	for i <= j && !s.less(j, a) {
+		j--
+	}
+	if i > j {
+		s.swap(j, a)
+		return j, true
+	}
+	s.swap(i, j)
+	i++
+	j--
+
+	for {
+		for i <= j && s.less(i, a) {
+			i++
+		}
+		for i <= j && !s.less(j, a) {
+			j--
+		}
+		if i > j {
+			break
+		}
+		s.swap(i, j)
+		i++
+		j--
+	}
+	s.swap(j, a)
+	return j, false
+}
+
+// partitionEqual partitions values[a:b] into values equal to the pivot
+// and values greater than it, given that none is less than it, and returns
+// the index of the first greater value
+func (s *sorter[T]) partitionEqual(a, b, pivot int) int {
+	s.swap(a, pivot)
+	i, j := a+1, b-1
+	for {
+		for i <= j && !s.less(a, i) {
+			i++
+		}
+		for i <= j && s.less(a, j) {
+			j--
+		}
+		if i > j {
+			break
+		}
+		s.swap(i, j)
+		i++
+		j--
+	}
+	return i
+}
+
+// partialInsertionSort sorts values[a:b] if only a few values are out of
+// place, and reports whether it did
+func (s *sorter[T]) partialInsertionSort(a, b int) bool {
+	i := a + 1
+	for step := 0; step < maxPartialSteps; step++ {
+		for i < b && !s.less(i, i-1) {
+			i++
+		}
+		if i == b {
+			return true
+		}
+		if b-a < shortestShifting {
+			return false
+		}
+		s.swap(i, i-1)
+
+		// Shift the smaller value to the left and the greater one to the right
+		if i-a >= 2 {
+			for j := i - 1; j >= 1; j-- {
+				if !s.less(j, j-1) {
+					break
+				}
+				s.swap(j, j-1)
+			}
+		}
+		if b-i >= 2 {
+			for j := i + 1; j < b; j++ {
+				if !s.less(j, j-1) {
+					break
+				}
+				s.swap(j, j-1)
+			}
+		}
+	}
+	return false
+}
+
+// breakPatterns swaps three values around the middle of values[a:b] with
+// pseudo-random ones, deterministically seeded by the length
+func (s *sorter[T]) breakPatterns(a, b int) {
+	length := b - a
+	if length < 8 {
+		return
+	}
+	random := xorshift(length)
+	modulus := uint(1) << bits.Len(uint(length))
+	index := a + (length/4)*2 - 1
+	for i := 0; i < 3; i++ {
+		other := int(uint(random.next()) & (modulus - 1))
+		if other >= length {
+			other -= length
+		}
+		s.swap(index-1+i, a+other)
+	}
+}
+
+// choosePivot returns the index of a pivot for values[a:b] and how the
+// sampled values were ordered: the median of three values at a quarter, half
+// and three quarters of the run, or the median of the medians of their
+// neighborhoods for long runs
+func (s *sorter[T]) choosePivot(a, b int) (int, sortedHint) {
+	const maxSwaps = 4 * 3
+	length := b - a
+	swaps := 0
+	i, j, k := a+length/4*1, a+length/4*2, a+length/4*3
+	if length >= 8 {
+		if length >= shortestNinther {
+			i = s.medianAdjacent(i, &swaps)
+			j = s.medianAdjacent(j, &swaps)
+			k = s.medianAdjacent(k, &swaps)
+		}
+		j = s.median(i, j, k, &swaps)
+	}
+
+	switch swaps {
+	case 0:
+		return j, increasingHint
+	case maxSwaps:
+		return j, decreasingHint
+	default:
+		return j, unknownHint
+	}
+}
+
+// order2 returns the indexes ordered by their values, counting a swap
+func (s *sorter[T]) order2(a, b int, swaps *int) (int, int) {
+	if s.less(b, a) {
+		*swaps++
+		return b, a
+	}
+	return a, b
+}
+
+// median returns the index of the median of the three values
+func (s *sorter[T]) median(a, b, c int, swaps *int) int {
+	a, b = s.order2(a, b, swaps)
+	b, c = s.order2(b, c, swaps)
+	_, b = s.order2(a, b, swaps)
+	return b
+}
+
+// medianAdjacent returns the index of the median of the value and its neighbors
+func (s *sorter[T]) medianAdjacent(a int, swaps *int) int {
+	return s.median(a-1, a, a+1, swaps)
+}
+
+func (s *sorter[T]) reverse(a, b int) {
+	for i, j := a, b-1; i < j; i, j = i+1, j-1 {
+		s.swap(i, j)
+	}
+}
+
+// xorshift is a tiny deterministic pseudo-random generator
+type xorshift uint64
+
+func (r *xorshift) next() uint64 {
+	*r ^= *r << 13
+	*r ^= *r >> 7
+	*r ^= *r << 17
+	return uint64(*r)
+}
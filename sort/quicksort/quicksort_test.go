@@ -0,0 +1,213 @@
+package quicksort
+
+import (
+	"cmp"
+	"fmt"
+	"math/bits"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/TranThang-2804/golangds/sort/internal/sorttest"
+	"github.com/TranThang-2804/golangds/sort/observe"
+)
+
+func TestSort(t *testing.T) {
+	sorttest.Run(t, Sort[sorttest.Item], false)
+}
+
+func TestSortObserved(t *testing.T) {
+	sorttest.RunObserved(t, SortObserved[sorttest.Item], false)
+}
+
+func TestSortObservedHeapSortFallback(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	input := make([]sorttest.Item, 1000)
+	for i := range input {
+		input[i] = sorttest.Item{Key: random.Intn(100), Order: i}
+	}
+	values := slices.Clone(input)
+	trace := observe.NewTrace()
+	// No unbalanced partition left, the middle of the values is heap sorted
+	s := &sorter[sorttest.Item]{values: values, comparator: sorttest.Compare, observer: observe.Scope(trace)}
+	s.sort(100, 900, 0)
+	sorttest.Check(t, "fallback", input[100:900], values[100:900], false)
+	sorttest.CheckTrace(t, "fallback", input, values, trace)
+}
+
+// countingCompare compares integers and counts the comparisons
+func countingCompare(count *int) func(a, b int) int {
+	return func(a, b int) int {
+		*count++
+		return cmp.Compare(a, b)
+	}
+}
+
+// nLogN is the comparison budget of an O(n log n) sort with a small constant
+func nLogN(n int) int {
+	return 2 * n * bits.Len(uint(n))
+}
+
+func TestSortedRuns(t *testing.T) {
+	n := 10000
+	inputs := map[string][]int{"sorted": make([]int, n), "reversed": make([]int, n), "constant": make([]int, n)}
+	for i := 0; i < n; i++ {
+		inputs["sorted"][i] = i
+		inputs["reversed"][i] = n - i
+	}
+	for name, values := range inputs {
+		comparisons := 0
+		Sort(values, countingCompare(&comparisons))
+		if !slices.IsSorted(values) {
+			t.Errorf("%s: Got %v which is not sorted", name, values)
+		}
+		// Sorted and reversed runs are recognized in about one pass
+		if comparisons > 2*n {
+			t.Errorf("%s: Got %v comparisons expected at most %v", name, comparisons, 2*n)
+		}
+	}
+}
+
+func TestPatterns(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	n := 10000
+	patterns := map[string]func(i int) int{
+		"organ pipe":    func(i int) int { return min(i, n-i) },
+		"sawtooth":      func(i int) int { return i % 100 },
+		"few values":    func(i int) int { return random.Intn(3) },
+		"sorted tail":   func(i int) int { return max(i, n-100) + random.Intn(2) },
+		"push front":    func(i int) int { return (i + 1) % n },
+		"interleaved":   func(i int) int { return (i % 2) * i },
+		"random":        func(int) int { return random.Int() },
+		"almost sorted": func(i int) int { return i + random.Intn(3) - 1 },
+	}
+	for name, pattern := range patterns {
+		values := make([]int, n)
+		for i := range values {
+			values[i] = pattern(i)
+		}
+		comparisons := 0
+		Sort(values, countingCompare(&comparisons))
+		if !slices.IsSorted(values) {
+			t.Errorf("%s: Got %v which is not sorted", name, values)
+		}
+		if comparisons > nLogN(n) {
+			t.Errorf("%s: Got %v comparisons expected at most %v", name, comparisons, nLogN(n))
+		}
+	}
+}
+
+// adversary implements the killer adversary for quicksort by McIlroy. The
+// values start as "gas" and are frozen to increasing solid values only when
+// a comparison needs it, in the way that makes the pivots as bad as possible.
+//
+// Reference: https://www.cs.dartmouth.edu/~doug/mdmspe.pdf
+type adversary struct {
+	values      []int
+	gas         int
+	solid       int
+	candidate   int
+	comparisons int
+}
+
+func newAdversary(n int) *adversary {
+	a := &adversary{values: make([]int, n), gas: n}
+	for i := range a.values {
+		a.values[i] = a.gas
+	}
+	return a
+}
+
+func (a *adversary) compare(x, y int) int {
+	a.comparisons++
+	if a.values[x] == a.gas && a.values[y] == a.gas {
+		if x == a.candidate {
+			a.freeze(x)
+		} else {
+			a.freeze(y)
+		}
+	}
+	if a.values[x] == a.gas {
+		a.candidate = x
+	} else if a.values[y] == a.gas {
+		a.candidate = y
+	}
+	return cmp.Compare(a.values[x], a.values[y])
+}
+
+func (a *adversary) freeze(x int) {
+	a.values[x] = a.solid
+	a.solid++
+}
+
+func TestAdversary(t *testing.T) {
+	for _, n := range []int{100, 1000, 10000, 100000} {
+		t.Run(fmt.Sprint(n), func(t *testing.T) {
+			a := newAdversary(n)
+			// The sorted values are the indexes of the adversary values
+			indexes := make([]int, n)
+			for i := range indexes {
+				indexes[i] = i
+			}
+			Sort(indexes, a.compare)
+			if a.comparisons > nLogN(n) {
+				t.Errorf("Got %v comparisons expected at most %v", a.comparisons, nLogN(n))
+			}
+			for i := 1; i < n; i++ {
+				if a.values[indexes[i-1]] > a.values[indexes[i]] {
+					t.Fatalf("Got %v before %v", a.values[indexes[i-1]], a.values[indexes[i]])
+				}
+			}
+		})
+	}
+}
+
+func TestReplayedAdversary(t *testing.T) {
+	// Once frozen, the values chosen by the adversary form a fixed input
+	// that was bad for the pivots, sorting it again must stay fast
+	n := 10000
+	a := newAdversary(n)
+	indexes := make([]int, n)
+	for i := range indexes {
+		indexes[i] = i
+	}
+	Sort(indexes, a.compare)
+
+	values := slices.Clone(a.values)
+	comparisons := 0
+	Sort(values, countingCompare(&comparisons))
+	if !slices.IsSorted(values) {
+		t.Errorf("Got %v which is not sorted", values)
+	}
+	if comparisons > nLogN(n) {
+		t.Errorf("Got %v comparisons expected at most %v", comparisons, nLogN(n))
+	}
+}
+
+func BenchmarkSort100000(b *testing.B) {
+	random := rand.New(rand.NewSource(1))
+	input := make([]int, 100000)
+	for i := range input {
+		input[i] = random.Int()
+	}
+	values := make([]int, len(input))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		copy(values, input)
+		Sort(values, cmp.Compare[int])
+	}
+}
+
+func BenchmarkSortFunc100000(b *testing.B) {
+	random := rand.New(rand.NewSource(1))
+	input := make([]int, 100000)
+	for i := range input {
+		input[i] = random.Int()
+	}
+	values := make([]int, len(input))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		copy(values, input)
+		slices.SortFunc(values, cmp.Compare[int])
+	}
+}
@@ -0,0 +1,323 @@
+// Package radixsort implements stable radix sorts keyed by integers or strings.
+//
+// Radix sort distributes the values by one byte of their key at a time
+// instead of comparing them. The least significant digit (LSD) variants make
+// one pass per byte from the last one, the most significant digit (MSD)
+// variants recurse into the buckets of the first byte and stop as soon as a
+// bucket is small, which suits long keys with distinct prefixes. All of them
+// take a key extractor so that structs can be sorted by a field, need an O(n)
+// buffer and keep values with equal keys in their original order.
+//
+// Reference: https://en.wikipedia.org/wiki/Radix_sort
+package radixsort
+
+import (
+	"unsafe"
+
+	"github.com/TranThang-2804/golangds/sort/observe"
+)
+
+// Integer is a constraint that permits any integer type
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// radix is the number of buckets of a one byte digit
+const radix = 256
+
+// cutoff is the bucket size under which the MSD sorts fall back to a
+// comparison sort
+const cutoff = 32
+
+// LSD sorts the values in place by their integer key, one byte at a time
+// from the least significant one, in O(w*n) time for w-byte keys. Passes
+// over a byte that is the same for every key are skipped.
+func LSD[T any, K Integer](values []T, key func(T) K) {
+	LSDObserved(values, key, nil)
+}
+
+// LSDObserved is LSD reporting its operations to the observer, the keys
+// are not values and their moves are not reported
+func LSDObserved[T any, K Integer](values []T, key func(T) K, observer observe.Observer) {
+	if len(values) < 2 {
+		return
+	}
+	keys := make([]uint64, len(values))
+	for i, value := range values {
+		keys[i] = unsignedKey(key(value))
+	}
+	s := &distributor[T, uint64]{values: values, keys: keys, observer: observe.Scope(observer)}
+	s.lsd(keyBytes[K](), func(k uint64, pass int) int { return int(byte(k >> (8 * pass))) }, radix)
+}
+
+// MSD sorts the values in place by their integer key, distributing them by
+// the most significant byte first and recursing into every bucket
+func MSD[T any, K Integer](values []T, key func(T) K) {
+	MSDObserved(values, key, nil)
+}
+
+// MSDObserved is MSD reporting its operations to the observer, the keys
+// are not values and their moves are not reported
+func MSDObserved[T any, K Integer](values []T, key func(T) K, observer observe.Observer) {
+	if len(values) < 2 {
+		return
+	}
+	keys := make([]uint64, len(values))
+	for i, value := range values {
+		keys[i] = unsignedKey(key(value))
+	}
+	bytes := keyBytes[K]()
+	s := &distributor[T, uint64]{values: values, keys: keys, observer: observe.Scope(observer)}
+	s.msd(0, len(values), 0, func(k uint64, depth int) (int, bool) {
+		return int(byte(k >> (8 * (bytes - 1 - depth)))), depth < bytes-1
+	}, radix)
+}
+
+// LSDString sorts the values in place by their string key, one byte at a
+// time from the last position of the longest key, in O(L*n) time where L is
+// the length of the longest key. A key that ends sorts before the keys that
+// go on, so shorter keys come before the longer keys they prefix.
+func LSDString[T any](values []T, key func(T) string) {
+	LSDStringObserved(values, key, nil)
+}
+
+// LSDStringObserved is LSDString reporting its operations to the observer,
+// the keys are not values and their moves are not reported
+func LSDStringObserved[T any](values []T, key func(T) string, observer observe.Observer) {
+	if len(values) < 2 {
+		return
+	}
+	keys := make([]string, len(values))
+	longest := 0
+	for i, value := range values {
+		keys[i] = key(value)
+		longest = max(longest, len(keys[i]))
+	}
+	s := &distributor[T, string]{values: values, keys: keys, observer: observe.Scope(observer)}
+	// Bucket 0 holds the keys that end before the position
+	s.lsd(longest, func(k string, pass int) int { return digitAt(k, longest-1-pass) }, radix+1)
+}
+
+// MSDString sorts the values in place by their string key, distributing them
+// by the first byte and recursing into every bucket with the next byte. Only
+// the bytes up to the distinguishing prefix of every key are examined.
+func MSDString[T any](values []T, key func(T) string) {
+	MSDStringObserved(values, key, nil)
+}
+
+// MSDStringObserved is MSDString reporting its operations to the observer,
+// the keys are not values and their moves are not reported
+func MSDStringObserved[T any](values []T, key func(T) string, observer observe.Observer) {
+	if len(values) < 2 {
+		return
+	}
+	keys := make([]string, len(values))
+	for i, value := range values {
+		keys[i] = key(value)
+	}
+	s := &distributor[T, string]{values: values, keys: keys, observer: observe.Scope(observer)}
+	// Bucket 0 holds the keys that ended, they are all equal and need no
+	// more distribution
+	s.msd(0, len(values), 0, func(k string, depth int) (int, bool) {
+		digit := digitAt(k, depth)
+		return digit, digit > 0
+	}, radix+1)
+}
+
+// distributor moves values between buckets along with their keys
+type distributor[T any, K uint64 | string] struct {
+	values []T
+	keys   []K
+	// buffer and bufferKeys receive the values while they are distributed
+	buffer     []T
+	bufferKeys []K
+	observer   observe.Observer
+}
+
+// Arrays of the buffers reported to the observer
+const (
+	bufferArray observe.Array = 1
+	heldArray   observe.Array = 2
+)
+
+func (d *distributor[T, K]) allocBuffer() {
+	if d.buffer == nil {
+		d.buffer, d.bufferKeys = make([]T, len(d.values)), make([]K, len(d.values))
+		if d.observer != nil {
+			d.observer.Alloc(bufferArray, len(d.values))
+		}
+	}
+}
+
+// lsd distributes the values by digit, pass after pass, where digit returns
+// the digit of a key for a pass. A pass where every key has the same digit
+// moves nothing.
+func (d *distributor[T, K]) lsd(passes int, digit func(k K, pass int) int, digits int) {
+	d.allocBuffer()
+	source, target := observe.Values, bufferArray
+	sourceValues, targetValues := d.values, d.buffer
+	sourceKeys, targetKeys := d.keys, d.bufferKeys
+	count := make([]int, digits)
+	// keyDigits holds the digit of every key for the pass, found only once
+	keyDigits := make([]int, len(d.values))
+	for pass := 0; pass < passes; pass++ {
+		clear(count)
+		for i, k := range sourceKeys {
+			keyDigits[i] = digit(k, pass)
+			count[keyDigits[i]]++
+		}
+		if count[keyDigits[0]] == len(d.values) {
+			continue
+		}
+
+		// Turn the counts into the start of every bucket
+		offset := 0
+		for i, c := range count {
+			count[i] = offset
+			offset += c
+		}
+		for i, k := range sourceKeys {
+			to := &count[keyDigits[i]]
+			targetValues[*to] = sourceValues[i]
+			targetKeys[*to] = k
+			if d.observer != nil {
+				d.observer.Move(observe.Position{Array: source, Index: i}, observe.Position{Array: target, Index: *to})
+			}
+			*to++
+		}
+		source, target = target, source
+		sourceValues, targetValues = targetValues, sourceValues
+		sourceKeys, targetKeys = targetKeys, sourceKeys
+	}
+	if source != observe.Values {
+		copy(d.values, d.buffer)
+		if d.observer != nil {
+			for i := range d.values {
+				d.observer.Move(observe.Position{Array: bufferArray, Index: i}, observe.At(i))
+			}
+		}
+	}
+}
+
+// msd distributes values[low:high] by the digit of their key at depth, then
+// recurses into every bucket for which digit reports that keys go on
+func (d *distributor[T, K]) msd(low, high, depth int, digit func(k K, depth int) (int, bool), digits int) {
+	if high-low <= cutoff {
+		d.insertionSort(low, high)
+		return
+	}
+	d.allocBuffer()
+
+	count := make([]int, digits)
+	for _, k := range d.keys[low:high] {
+		b, _ := digit(k, depth)
+		count[b]++
+	}
+	start := make([]int, digits+1)
+	start[0] = low
+	for i, c := range count {
+		start[i+1] = start[i] + c
+	}
+
+	if first, _ := digit(d.keys[low], depth); count[first] != high-low {
+		next := make([]int, digits)
+		copy(next, start)
+		for i := low; i < high; i++ {
+			b, _ := digit(d.keys[i], depth)
+			d.buffer[next[b]], d.bufferKeys[next[b]] = d.values[i], d.keys[i]
+			if d.observer != nil {
+				d.observer.Move(observe.At(i), observe.Position{Array: bufferArray, Index: next[b]})
+			}
+			next[b]++
+		}
+		copy(d.values[low:high], d.buffer[low:high])
+		copy(d.keys[low:high], d.bufferKeys[low:high])
+		if d.observer != nil {
+			for i := low; i < high; i++ {
+				d.observer.Move(observe.Position{Array: bufferArray, Index: i}, observe.At(i))
+			}
+		}
+	}
+
+	for b := 0; b < digits; b++ {
+		if count[b] < 2 {
+			continue
+		}
+		if _, more := digit(d.keys[start[b]], depth); more {
+			d.msd(start[b], start[b+1], depth+1, digit, digits)
+		}
+	}
+}
+
+// insertionSort sorts values[low:high] by their keys, stable
+func (d *distributor[T, K]) insertionSort(low, high int) {
+	if d.observer != nil && high-low > 1 {
+		// A new held array each time, the observer may see it as a new buffer
+		d.observer.Alloc(heldArray, 1)
+	}
+	held := observe.Position{Array: heldArray}
+	for i := low + 1; i < high; i++ {
+		value, k := d.values[i], d.keys[i]
+		if d.observer != nil {
+			d.observer.Move(observe.At(i), held)
+		}
+		j := i
+		for ; j > low; j-- {
+			if d.observer != nil {
+				d.observer.Compare(observe.At(j-1), held, compareKeys(d.keys[j-1], k))
+			}
+			if d.keys[j-1] <= k {
+				break
+			}
+			d.values[j], d.keys[j] = d.values[j-1], d.keys[j-1]
+			if d.observer != nil {
+				d.observer.Move(observe.At(j-1), observe.At(j))
+			}
+		}
+		d.values[j], d.keys[j] = value, k
+		if d.observer != nil {
+			d.observer.Move(held, observe.At(j))
+		}
+	}
+}
+
+func compareKeys[K uint64 | string](a, b K) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// keyBytes returns the number of bytes of K, the number of digits of its keys
+func keyBytes[K Integer]() int {
+	var zero K
+	return int(unsafe.Sizeof(zero))
+}
+
+// unsignedKey maps an integer to an unsigned one with the same order by
+// flipping the sign bit of signed types
+func unsignedKey[K Integer](k K) uint64 {
+	var zero K
+	bits := 8 * unsafe.Sizeof(zero)
+	u := uint64(k)
+	if bits < 64 {
+		u &= 1<<bits - 1
+	}
+	if ^zero < 0 {
+		u ^= 1 << (bits - 1)
+	}
+	return u
+}
+
+// digitAt returns the byte of the key at the position shifted by one,
+// or 0 past the end of the key
+func digitAt(k string, position int) int {
+	if position < len(k) {
+		return int(k[position]) + 1
+	}
+	return 0
+}
@@ -0,0 +1,223 @@
+package radixsort
+
+import (
+	"cmp"
+	"math"
+	"math/rand"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/TranThang-2804/golangds/list"
+	"github.com/TranThang-2804/golangds/sort/internal/sorttest"
+	"github.com/TranThang-2804/golangds/sort/observe"
+)
+
+func itemKey(item sorttest.Item) int {
+	return item.Key
+}
+
+func TestLSD(t *testing.T) {
+	sorttest.Run(t, func(values []sorttest.Item, _ list.Comparator[sorttest.Item]) {
+		LSD(values, itemKey)
+	}, true)
+}
+
+func TestMSD(t *testing.T) {
+	sorttest.Run(t, func(values []sorttest.Item, _ list.Comparator[sorttest.Item]) {
+		MSD(values, itemKey)
+	}, true)
+}
+
+func TestLSDString(t *testing.T) {
+	sorttest.Run(t, func(values []sorttest.Item, _ list.Comparator[sorttest.Item]) {
+		LSDString(values, paddedKey)
+	}, true)
+}
+
+func TestMSDString(t *testing.T) {
+	sorttest.Run(t, func(values []sorttest.Item, _ list.Comparator[sorttest.Item]) {
+		MSDString(values, paddedKey)
+	}, true)
+}
+
+func TestObserved(t *testing.T) {
+	sorttest.RunObserved(t, func(values []sorttest.Item, _ list.Comparator[sorttest.Item], observer observe.Observer) {
+		LSDObserved(values, itemKey, observer)
+	}, true)
+	sorttest.RunObserved(t, func(values []sorttest.Item, _ list.Comparator[sorttest.Item], observer observe.Observer) {
+		MSDObserved(values, itemKey, observer)
+	}, true)
+	sorttest.RunObserved(t, func(values []sorttest.Item, _ list.Comparator[sorttest.Item], observer observe.Observer) {
+		LSDStringObserved(values, paddedKey, observer)
+	}, true)
+	sorttest.RunObserved(t, func(values []sorttest.Item, _ list.Comparator[sorttest.Item], observer observe.Observer) {
+		MSDStringObserved(values, paddedKey, observer)
+	}, true)
+}
+
+// paddedKey formats the key so that string order matches integer order
+func paddedKey(item sorttest.Item) string {
+	key := []byte("0000000000")
+	for i, k := len(key)-1, item.Key; k > 0; i, k = i-1, k/10 {
+		key[i] = byte('0' + k%10)
+	}
+	return string(key)
+}
+
+func testIntegers[K Integer](t *testing.T, keys []K) {
+	t.Helper()
+	expected := slices.Clone(keys)
+	slices.Sort(expected)
+	identity := func(k K) K { return k }
+	for name, sort := range map[string]func([]K, func(K) K){"LSD": LSD[K, K], "MSD": MSD[K, K]} {
+		actual := slices.Clone(keys)
+		sort(actual, identity)
+		if !slices.Equal(actual, expected) {
+			t.Errorf("%s: Got %v expected %v", name, actual, expected)
+		}
+	}
+}
+
+func TestIntegerTypes(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	int8s := []int8{math.MaxInt8, math.MinInt8, 0, -1, 1}
+	int64s := []int64{math.MaxInt64, math.MinInt64, 0, -1, 1}
+	uints := []uint{math.MaxUint, 0, 1, 1 << 40}
+	uint16s := []uint16{math.MaxUint16, 0, 256, 255}
+	for i := 0; i < 1000; i++ {
+		int8s = append(int8s, int8(random.Int()))
+		int64s = append(int64s, random.Int63()-random.Int63())
+		uints = append(uints, uint(random.Uint64()))
+		uint16s = append(uint16s, uint16(random.Int()))
+	}
+	testIntegers(t, int8s)
+	testIntegers(t, int64s)
+	testIntegers(t, uints)
+	testIntegers(t, uint16s)
+}
+
+func TestStrings(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	keys := []string{"", "a", "ab", "", "abc", "b", "\xff", "\x00", "a\x00"}
+	for i := 0; i < 2000; i++ {
+		// Short alphabet and shared prefixes to exercise deep buckets
+		var key strings.Builder
+		key.WriteString([]string{"", "pre", "prefix", "prefixed"}[random.Intn(4)])
+		for j := random.Intn(6); j > 0; j-- {
+			key.WriteByte("abc"[random.Intn(3)])
+		}
+		keys = append(keys, key.String())
+	}
+
+	expected := slices.Clone(keys)
+	slices.Sort(expected)
+	identity := func(k string) string { return k }
+	for name, sort := range map[string]func([]string, func(string) string){"LSDString": LSDString[string], "MSDString": MSDString[string]} {
+		actual := slices.Clone(keys)
+		sort(actual, identity)
+		if !slices.Equal(actual, expected) {
+			t.Errorf("%s: Got %v expected %v", name, actual, expected)
+		}
+	}
+}
+
+func TestStructs(t *testing.T) {
+	type employee struct {
+		name string
+		age  uint8
+	}
+	employees := []employee{{"eve", 40}, {"bob", 25}, {"amy", 40}, {"dan", 25}, {"cat", 31}}
+	LSD(employees, func(e employee) uint8 { return e.age })
+	expected := []employee{{"bob", 25}, {"dan", 25}, {"cat", 31}, {"eve", 40}, {"amy", 40}}
+	if !slices.Equal(employees, expected) {
+		t.Errorf("Got %v expected %v", employees, expected)
+	}
+	MSDString(employees, func(e employee) string { return e.name })
+	if actualValue, expectedValue := employees[0].name, "amy"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func testLSDMoves[K Integer](t *testing.T, keys []K, expectedValue int64) {
+	t.Helper()
+	counter := observe.NewCounter()
+	LSDObserved(keys, func(k K) K { return k }, counter)
+	if actualValue := counter.Moves(); actualValue != expectedValue {
+		t.Errorf("%T: Got %v expected %v", keys, actualValue, expectedValue)
+	}
+}
+
+func TestLSDPasses(t *testing.T) {
+	// Every byte of the keys differs, so there is one pass moving both keys
+	// per byte, plus a copy back after an odd number of passes
+	testLSDMoves(t, []int8{0x01, 0x02}, 2*2)
+	testLSDMoves(t, []uint16{0x0102, 0x0201}, 2*2)
+	testLSDMoves(t, []int32{0x01020304, 0x04030201}, 2*4)
+	testLSDMoves(t, []int64{0x0102030405060708, 0x0807060504030201}, 2*8)
+	// Passes over a byte shared by every key are skipped
+	testLSDMoves(t, []uint64{0x0100, 0x0200}, 2*2)
+}
+
+func randomInts(n int) []int {
+	random := rand.New(rand.NewSource(1))
+	values := make([]int, n)
+	for i := range values {
+		values[i] = random.Int()
+	}
+	return values
+}
+
+func randomStrings(n int) []string {
+	random := rand.New(rand.NewSource(1))
+	values := make([]string, n)
+	for i := range values {
+		key := make([]byte, 8+random.Intn(8))
+		for j := range key {
+			key[j] = byte('a' + random.Intn(26))
+		}
+		values[i] = string(key)
+	}
+	return values
+}
+
+func benchmark[K any](b *testing.B, input []K, sort func([]K)) {
+	values := make([]K, len(input))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		copy(values, input)
+		sort(values)
+	}
+}
+
+func BenchmarkLSD100000(b *testing.B) {
+	benchmark(b, randomInts(100000), func(values []int) { LSD(values, func(k int) int { return k }) })
+}
+
+func BenchmarkLSDInt64_1000000(b *testing.B) {
+	input := make([]int64, 1000000)
+	for i, k := range randomInts(len(input)) {
+		input[i] = int64(k)
+	}
+	benchmark(b, input, func(values []int64) { LSD(values, func(k int64) int64 { return k }) })
+}
+
+func BenchmarkMSD100000(b *testing.B) {
+	benchmark(b, randomInts(100000), func(values []int) { MSD(values, func(k int) int { return k }) })
+}
+
+func BenchmarkIntsSortFunc100000(b *testing.B) {
+	benchmark(b, randomInts(100000), func(values []int) { slices.SortFunc(values, cmp.Compare[int]) })
+}
+
+func BenchmarkLSDString100000(b *testing.B) {
+	benchmark(b, randomStrings(100000), func(values []string) { LSDString(values, func(k string) string { return k }) })
+}
+
+func BenchmarkMSDString100000(b *testing.B) {
+	benchmark(b, randomStrings(100000), func(values []string) { MSDString(values, func(k string) string { return k }) })
+}
+
+func BenchmarkStringsSortFunc100000(b *testing.B) {
+	benchmark(b, randomStrings(100000), func(values []string) { slices.SortFunc(values, strings.Compare) })
+}
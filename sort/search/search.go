@@ -0,0 +1,154 @@
+// Package search finds values in sorted slices and lists.
+//
+// The searches take the comparator the values were sorted with and run in
+// O(log n) comparisons. LowerBound and UpperBound return the first index
+// whose value is not less than, respectively greater than, the target, so
+// that EqualRange is the range between them. The Of variants search any
+// list read by index, such as the linked lists of this module once sorted
+// with their Sort method. Every Get takes O(n) time on a linked list, so they
+// take O(n log n) time there, still with O(log n) comparisons.
+//
+// Reference: https://en.wikipedia.org/wiki/Binary_search_algorithm
+package search
+
+import (
+	"cmp"
+
+	"github.com/TranThang-2804/golangds/list"
+)
+
+// RandomAccess is a sequence read by index, which every list.List is
+type RandomAccess[T any] interface {
+	Get(index int) (T, bool)
+	GetSize() int
+}
+
+// BinarySearch returns the index of the first value equal to the target in
+// the sorted values, or the index where the target would be inserted
+// return false if no value is equal to the target
+func BinarySearch[T any](values []T, target T, comparator list.Comparator[T]) (int, bool) {
+	i := LowerBound(values, target, comparator)
+	return i, i < len(values) && comparator(values[i], target) == 0
+}
+
+// LowerBound returns the index of the first value not less than the target
+// in the sorted values, len(values) if there is none
+func LowerBound[T any](values []T, target T, comparator list.Comparator[T]) int {
+	return first(len(values), func(i int) bool { return comparator(values[i], target) >= 0 })
+}
+
+// UpperBound returns the index of the first value greater than the target
+// in the sorted values, len(values) if there is none
+func UpperBound[T any](values []T, target T, comparator list.Comparator[T]) int {
+	return first(len(values), func(i int) bool { return comparator(values[i], target) > 0 })
+}
+
+// EqualRange returns the bounds of the values equal to the target in the
+// sorted values, values[low:high], which is empty at the insertion point
+// of the target if there is none
+func EqualRange[T any](values []T, target T, comparator list.Comparator[T]) (int, int) {
+	return LowerBound(values, target, comparator), UpperBound(values, target, comparator)
+}
+
+// BinarySearchOf is BinarySearch on a sorted list read by index
+func BinarySearchOf[T any](l RandomAccess[T], target T, comparator list.Comparator[T]) (int, bool) {
+	i := LowerBoundOf(l, target, comparator)
+	if i == l.GetSize() {
+		return i, false
+	}
+	value, _ := l.Get(i)
+	return i, comparator(value, target) == 0
+}
+
+// LowerBoundOf is LowerBound on a sorted list read by index
+func LowerBoundOf[T any](l RandomAccess[T], target T, comparator list.Comparator[T]) int {
+	return first(l.GetSize(), func(i int) bool {
+		value, _ := l.Get(i)
+		return comparator(value, target) >= 0
+	})
+}
+
+// UpperBoundOf is UpperBound on a sorted list read by index
+func UpperBoundOf[T any](l RandomAccess[T], target T, comparator list.Comparator[T]) int {
+	return first(l.GetSize(), func(i int) bool {
+		value, _ := l.Get(i)
+		return comparator(value, target) > 0
+	})
+}
+
+// EqualRangeOf is EqualRange on a sorted list read by index
+func EqualRangeOf[T any](l RandomAccess[T], target T, comparator list.Comparator[T]) (int, int) {
+	return LowerBoundOf(l, target, comparator), UpperBoundOf(l, target, comparator)
+}
+
+// first returns the smallest index in [0, n) for which found is true,
+// n if there is none, given that found is false then true over the range
+func first(n int, found func(i int) bool) int {
+	low, high := 0, n
+	for low < high {
+		middle := int(uint(low+high) >> 1)
+		if found(middle) {
+			high = middle
+		} else {
+			low = middle + 1
+		}
+	}
+	return low
+}
+
+// IsSorted checks if no value is less than the value before it
+func IsSorted[T any](values []T, comparator list.Comparator[T]) bool {
+	for i := 1; i < len(values); i++ {
+		if comparator(values[i], values[i-1]) < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSortedBy checks if no key is less than the key of the value before it
+func IsSortedBy[T any, K cmp.Ordered](values []T, key func(T) K) bool {
+	if len(values) < 2 {
+		return true
+	}
+	previous := key(values[0])
+	for _, value := range values[1:] {
+		k := key(value)
+		if cmp.Less(k, previous) {
+			return false
+		}
+		previous = k
+	}
+	return true
+}
+
+// IsSortedOf is IsSorted on a list, read in one pass with GetAllNode
+func IsSortedOf[T any](l list.List[T], comparator list.Comparator[T]) bool {
+	return IsSorted(l.GetAllNode(), comparator)
+}
+
+// Merge returns the values of the sorted a and b in a new sorted slice,
+// taking from a first on ties so that the merge is stable
+func Merge[T any](a, b []T, comparator list.Comparator[T]) []T {
+	merged := make([]T, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		if comparator(b[j], a[i]) < 0 {
+			merged = append(merged, b[j])
+			j++
+		} else {
+			merged = append(merged, a[i])
+			i++
+		}
+	}
+	merged = append(merged, a[i:]...)
+	return append(merged, b[j:]...)
+}
+
+// MergeOf appends the values of the sorted lists a and b to the target in
+// sorted order, taking from a first on ties. The lists are read in one pass
+// with GetAllNode and are left untouched, the target may be empty or any
+// list to append to.
+func MergeOf[T any](target, a, b list.List[T], comparator list.Comparator[T]) {
+	target.Append(Merge(a.GetAllNode(), b.GetAllNode(), comparator)...)
+}
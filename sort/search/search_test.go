@@ -0,0 +1,197 @@
+package search
+
+import (
+	"cmp"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/TranThang-2804/golangds/list"
+	"github.com/TranThang-2804/golangds/list/doublelinkedlist"
+	"github.com/TranThang-2804/golangds/list/linkedlist"
+	"github.com/TranThang-2804/golangds/sort/internal/sorttest"
+)
+
+func TestBinarySearch(t *testing.T) {
+	values := []int{1, 3, 3, 3, 5, 8}
+	for _, test := range []struct {
+		target, index int
+		found         bool
+	}{
+		{0, 0, false},
+		{1, 0, true},
+		{3, 1, true},
+		{4, 4, false},
+		{8, 5, true},
+		{9, 6, false},
+	} {
+		index, found := BinarySearch(values, test.target, cmp.Compare[int])
+		if actualValue, expectedValue := index, test.index; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := found, test.found; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+
+	if actualValue, actualFound := BinarySearch(nil, 1, cmp.Compare[int]); actualValue != 0 || actualFound {
+		t.Errorf("Got %v %v expected %v %v", actualValue, actualFound, 0, false)
+	}
+}
+
+func TestBounds(t *testing.T) {
+	values := []int{1, 3, 3, 3, 5, 8}
+	for _, test := range []struct{ target, low, high int }{
+		{0, 0, 0},
+		{1, 0, 1},
+		{3, 1, 4},
+		{4, 4, 4},
+		{8, 5, 6},
+		{9, 6, 6},
+	} {
+		if actualValue, expectedValue := LowerBound(values, test.target, cmp.Compare[int]), test.low; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := UpperBound(values, test.target, cmp.Compare[int]), test.high; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		low, high := EqualRange(values, test.target, cmp.Compare[int])
+		if low != test.low || high != test.high {
+			t.Errorf("Got %v %v expected %v %v", low, high, test.low, test.high)
+		}
+	}
+}
+
+func TestBoundsRandom(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	for n := 0; n < 50; n++ {
+		values := make([]int, n)
+		for i := range values {
+			values[i] = random.Intn(10)
+		}
+		slices.Sort(values)
+		for target := -1; target <= 10; target++ {
+			expected, _ := slices.BinarySearch(values, target)
+			if actualValue, expectedValue := LowerBound(values, target, cmp.Compare[int]), expected; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+			expected, _ = slices.BinarySearch(values, target+1)
+			if actualValue, expectedValue := UpperBound(values, target, cmp.Compare[int]), expected; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		}
+	}
+}
+
+func TestOf(t *testing.T) {
+	for _, l := range []list.List[int]{linkedlist.New[int](), doublelinkedlist.New[int]()} {
+		l.Append(5, 3, 8, 3, 1, 3)
+		l.Sort(cmp.Compare[int])
+		if actualValue, actualFound := BinarySearchOf(l, 3, cmp.Compare[int]); actualValue != 1 || !actualFound {
+			t.Errorf("Got %v %v expected %v %v", actualValue, actualFound, 1, true)
+		}
+		if actualValue, actualFound := BinarySearchOf(l, 4, cmp.Compare[int]); actualValue != 4 || actualFound {
+			t.Errorf("Got %v %v expected %v %v", actualValue, actualFound, 4, false)
+		}
+		if actualValue, actualFound := BinarySearchOf(l, 9, cmp.Compare[int]); actualValue != 6 || actualFound {
+			t.Errorf("Got %v %v expected %v %v", actualValue, actualFound, 6, false)
+		}
+		if low, high := EqualRangeOf(l, 3, cmp.Compare[int]); low != 1 || high != 4 {
+			t.Errorf("Got %v %v expected %v %v", low, high, 1, 4)
+		}
+		if actualValue, expectedValue := LowerBoundOf(l, 0, cmp.Compare[int]), 0; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := UpperBoundOf(l, 8, cmp.Compare[int]), 6; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+}
+
+func TestIsSorted(t *testing.T) {
+	for _, test := range []struct {
+		values []int
+		sorted bool
+	}{
+		{nil, true},
+		{[]int{1}, true},
+		{[]int{1, 1, 2}, true},
+		{[]int{2, 1}, false},
+		{[]int{1, 3, 2, 4}, false},
+	} {
+		if actualValue, expectedValue := IsSorted(test.values, cmp.Compare[int]), test.sorted; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v for %v", actualValue, expectedValue, test.values)
+		}
+		if actualValue, expectedValue := IsSortedBy(test.values, func(v int) int { return v }), test.sorted; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v for %v", actualValue, expectedValue, test.values)
+		}
+	}
+
+	words := []string{"fig", "pear", "banana"}
+	if actualValue, expectedValue := IsSortedBy(words, func(w string) int { return len(w) }), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := IsSortedBy(words, func(w string) string { return w }), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	l := linkedlist.New[int]()
+	l.Append(3, 1, 2)
+	if actualValue, expectedValue := IsSortedOf[int](l, cmp.Compare[int]), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	l.Sort(cmp.Compare[int])
+	if actualValue, expectedValue := IsSortedOf[int](l, cmp.Compare[int]), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMerge(t *testing.T) {
+	// Equal keys of a come before those of b
+	a := []sorttest.Item{{Key: 1, Order: 0}, {Key: 3, Order: 1}, {Key: 3, Order: 2}, {Key: 7, Order: 3}}
+	b := []sorttest.Item{{Key: 0, Order: 4}, {Key: 3, Order: 5}, {Key: 9, Order: 6}}
+	merged := Merge(a, b, sorttest.Compare)
+	input := append(slices.Clone(a), b...)
+	sorttest.Check(t, "merge", input, merged, true)
+
+	if actualValue, expectedValue := len(Merge(nil, []int{1, 2}, cmp.Compare[int])), 2; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMergeOf(t *testing.T) {
+	a, b := linkedlist.New[int](), doublelinkedlist.New[int]()
+	a.Append(1, 4, 6)
+	b.Append(2, 4, 5, 9)
+	target := linkedlist.New[int]()
+	target.Append(0)
+	MergeOf[int](target, a, b, cmp.Compare[int])
+	expected := []int{0, 1, 2, 4, 4, 5, 6, 9}
+	if actualValue, expectedValue := target.GetAllNode(), expected; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := a.GetSize()+b.GetSize(), 7; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestOfNonComparable(t *testing.T) {
+	// Lists of slices, built with NewFunc, are searched and merged too
+	l := linkedlist.NewFunc(slices.Equal[[]int])
+	l.Append([]int{3}, []int{1, 2}, []int{1})
+	l.Sort(slices.Compare[[]int])
+	if actualValue, actualFound := BinarySearchOf(l, []int{1, 2}, slices.Compare[[]int]); actualValue != 1 || !actualFound {
+		t.Errorf("Got %v %v expected %v %v", actualValue, actualFound, 1, true)
+	}
+	if actualValue, expectedValue := IsSortedOf[[]int](l, slices.Compare[[]int]), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	other, target := doublelinkedlist.NewFunc(slices.Equal[[]int]), linkedlist.NewFunc(slices.Equal[[]int])
+	other.Append([]int{0}, []int{2})
+	MergeOf[[]int](target, l, other, slices.Compare[[]int])
+	expected := [][]int{{0}, {1}, {1, 2}, {2}, {3}}
+	if actualValue := target.GetAllNode(); !slices.EqualFunc(actualValue, expected, slices.Equal[[]int]) {
+		t.Errorf("Got %v expected %v", actualValue, expected)
+	}
+}
@@ -0,0 +1,172 @@
+// Package selection finds the smallest or largest values of a collection
+// without sorting all of it.
+//
+// Select finds the k-th smallest value with quickselect in O(n) expected
+// time, and switches to the median-of-medians pivot when partitions keep
+// going badly, which bounds it to O(n) in the worst case too. PartialSort
+// builds on it to sort only the k smallest values. TopK keeps the k largest
+// values of a stream in a bounded heap.
+//
+// Reference: https://en.wikipedia.org/wiki/Selection_algorithm
+package selection
+
+import (
+	"github.com/TranThang-2804/golangds/list"
+	"github.com/TranThang-2804/golangds/sort/heapsort"
+	"github.com/TranThang-2804/golangds/sort/insertionsort"
+	"github.com/TranThang-2804/golangds/sort/observe"
+)
+
+// smallRun is the length under which runs are insertion sorted
+const smallRun = 16
+
+// maxBadPartitions is the number of partitions keeping more than three
+// quarters of the values tolerated before switching to median-of-medians
+const maxBadPartitions = 2
+
+// Select rearranges the values so that values[k] is the value that would be
+// at index k if they were sorted, with no greater value before it and no
+// smaller value after it, and returns it.
+// return false if k is out of range
+func Select[T any](values []T, k int, comparator list.Comparator[T]) (T, bool) {
+	return SelectObserved(values, k, comparator, nil)
+}
+
+// SelectObserved is Select reporting its operations to the observer
+func SelectObserved[T any](values []T, k int, comparator list.Comparator[T], observer observe.Observer) (T, bool) {
+	if k < 0 || k >= len(values) {
+		var zeroValue T
+		return zeroValue, false
+	}
+	s := &selector[T]{values: values, comparator: comparator, observer: observe.Scope(observer)}
+	s.selectIndex(0, len(values), k)
+	return values[k], true
+}
+
+// PartialSort rearranges the values so that values[:k] holds the k smallest
+// values in order, the rest is left in no particular order. A k beyond the
+// length sorts all of the values.
+func PartialSort[T any](values []T, k int, comparator list.Comparator[T]) {
+	PartialSortObserved(values, k, comparator, nil)
+}
+
+// PartialSortObserved is PartialSort reporting its operations to the observer
+func PartialSortObserved[T any](values []T, k int, comparator list.Comparator[T], observer observe.Observer) {
+	if k <= 0 {
+		return
+	}
+	o := observe.Scope(observer)
+	if k < len(values) {
+		s := &selector[T]{values: values, comparator: comparator, observer: o}
+		s.selectIndex(0, len(values), k-1)
+	}
+	heapsort.SortObserved(values[:min(k, len(values))], comparator, observe.Slice(o, observe.At(0)))
+}
+
+type selector[T any] struct {
+	values     []T
+	comparator list.Comparator[T]
+	observer   observe.Observer
+}
+
+func (s *selector[T]) compare(i, j int) int {
+	order := s.comparator(s.values[i], s.values[j])
+	if s.observer != nil {
+		s.observer.Compare(observe.At(i), observe.At(j), order)
+	}
+	return order
+}
+
+func (s *selector[T]) swap(i, j int) {
+	s.values[i], s.values[j] = s.values[j], s.values[i]
+	if s.observer != nil {
+		s.observer.Swap(observe.At(i), observe.At(j))
+	}
+}
+
+// insertionSort sorts values[a:b]
+func (s *selector[T]) insertionSort(a, b int) {
+	insertionsort.SortObserved(s.values[a:b], s.comparator, observe.Slice(s.observer, observe.At(a)))
+}
+
+// selectIndex puts in place the value of values[a:b] at index k
+func (s *selector[T]) selectIndex(a, b, k int) {
+	badPartitions := 0
+	for b-a > smallRun {
+		var pivot int
+		if badPartitions < maxBadPartitions {
+			pivot = s.medianOfThree(a, b)
+		} else {
+			pivot = s.medianOfMedians(a, b)
+		}
+
+		n := b - a
+		low, high := s.partition(a, b, pivot)
+		switch {
+		case k < low:
+			b = low
+		case k >= high:
+			a = high
+		default:
+			return
+		}
+		if b-a > n*3/4 {
+			badPartitions++
+		}
+	}
+	s.insertionSort(a, b)
+}
+
+// medianOfThree returns the index of the median of the first, middle and
+// last values of values[a:b]
+func (s *selector[T]) medianOfThree(a, b int) int {
+	i, j, k := a, a+(b-a)/2, b-1
+	if s.compare(j, i) < 0 {
+		i, j = j, i
+	}
+	if s.compare(k, j) < 0 {
+		j = k
+		if s.compare(j, i) < 0 {
+			j = i
+		}
+	}
+	return j
+}
+
+// medianOfMedians moves the median of every group of five values of
+// values[a:b] to the front and returns the index of the median of those
+// medians, a pivot that is guaranteed to have at least 30% of the values on
+// either side
+func (s *selector[T]) medianOfMedians(a, b int) int {
+	groups := 0
+	for low := a; low < b; low += 5 {
+		high := min(low+5, b)
+		s.insertionSort(low, high)
+		s.swap(a+groups, low+(high-low)/2)
+		groups++
+	}
+	s.selectIndex(a, a+groups, a+groups/2)
+	return a + groups/2
+}
+
+// partition rearranges values[a:b] into values less than, equal to and
+// greater than the value at pivot, and returns the bounds of the equal values
+func (s *selector[T]) partition(a, b, pivot int) (int, int) {
+	// Keep the pivot in front of the equal values while partitioning
+	s.swap(a, pivot)
+	low, i, high := a, a+1, b
+	for i < high {
+		switch order := s.compare(i, low); {
+		case order < 0:
+			s.swap(low, i)
+			low++
+			i++
+		case order > 0:
+			high--
+			s.swap(i, high)
+		default:
+			i++
+		}
+	}
+	return low, high
+}
@@ -0,0 +1,217 @@
+package selection
+
+import (
+	"cmp"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/TranThang-2804/golangds/list/doublelinkedlist"
+	"github.com/TranThang-2804/golangds/list/linkedlist"
+	"github.com/TranThang-2804/golangds/sort/internal/sorttest"
+	"github.com/TranThang-2804/golangds/sort/observe"
+)
+
+func TestSelect(t *testing.T) {
+	for _, input := range sorttest.Inputs() {
+		sorted := slices.Clone(input.Values)
+		slices.SortStableFunc(sorted, sorttest.Compare)
+		if len(sorted) == 0 {
+			continue
+		}
+		for _, k := range []int{0, len(sorted) / 3, len(sorted) / 2, len(sorted) - 1} {
+			values := slices.Clone(input.Values)
+			actualValue, ok := Select(values, k, sorttest.Compare)
+			if !ok || actualValue.Key != sorted[k].Key {
+				t.Fatalf("%s: Got %v expected %v", input.Name, actualValue, sorted[k])
+			}
+			for i, value := range values {
+				if (i < k && value.Key > actualValue.Key) || (i > k && value.Key < actualValue.Key) {
+					t.Fatalf("%s: Got %v at %d around %v at %d", input.Name, value, i, actualValue, k)
+				}
+			}
+			sorttest.Check(t, input.Name, input.Values, sortedCopy(values), false)
+		}
+	}
+}
+
+func sortedCopy(values []sorttest.Item) []sorttest.Item {
+	values = slices.Clone(values)
+	slices.SortFunc(values, sorttest.Compare)
+	return values
+}
+
+func TestSelectOutOfRange(t *testing.T) {
+	values := []int{3, 1, 2}
+	for _, k := range []int{-1, 3} {
+		if _, ok := Select(values, k, cmp.Compare[int]); ok != false {
+			t.Errorf("Got %v expected %v", ok, false)
+		}
+	}
+	if _, ok := Select([]int{}, 0, cmp.Compare[int]); ok != false {
+		t.Errorf("Got %v expected %v", ok, false)
+	}
+}
+
+func TestSelectLinearComparisons(t *testing.T) {
+	// Inputs that defeat a median-of-three pivot must still take a linear
+	// number of comparisons thanks to the median-of-medians fallback
+	n := 100000
+	inputs := map[string][]int{"sorted": make([]int, n), "reversed": make([]int, n), "organ pipe": make([]int, n), "sawtooth": make([]int, n)}
+	for i := 0; i < n; i++ {
+		inputs["sorted"][i] = i
+		inputs["reversed"][i] = n - i
+		inputs["organ pipe"][i] = min(i, n-i)
+		inputs["sawtooth"][i] = i % 1000
+	}
+	// A median-of-three killer: the median of first, middle and last is
+	// always the second smallest value
+	killer := make([]int, n)
+	for i := range killer {
+		killer[i] = i
+	}
+	for i := n - 1; i > 0; i-- {
+		killer[i], killer[i/2] = killer[i/2], killer[i]
+	}
+	inputs["killer"] = killer
+
+	for name, input := range inputs {
+		for _, k := range []int{0, n / 2, n - 1} {
+			comparisons := 0
+			values := slices.Clone(input)
+			Select(values, k, func(a, b int) int {
+				comparisons++
+				return cmp.Compare(a, b)
+			})
+			if comparisons > 40*n {
+				t.Errorf("%s k=%d: Got %v comparisons expected at most %v", name, k, comparisons, 40*n)
+			}
+			expected := slices.Clone(input)
+			slices.Sort(expected)
+			if values[k] != expected[k] {
+				t.Errorf("%s k=%d: Got %v expected %v", name, k, values[k], expected[k])
+			}
+		}
+	}
+}
+
+func TestMedianOfMedians(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	values := make([]int, 1001)
+	for i := range values {
+		values[i] = random.Intn(10000)
+	}
+	s := &selector[int]{values: values, comparator: cmp.Compare[int]}
+	pivot := values[s.medianOfMedians(0, len(values))]
+	less, greater := 0, 0
+	for _, value := range values {
+		if value < pivot {
+			less++
+		} else if value > pivot {
+			greater++
+		}
+	}
+	if less > len(values)*7/10 || greater > len(values)*7/10 {
+		t.Errorf("Got %v less and %v greater than the pivot", less, greater)
+	}
+}
+
+func TestPartialSort(t *testing.T) {
+	for _, input := range sorttest.Inputs() {
+		sorted := sortedCopy(input.Values)
+		for _, k := range []int{0, 1, 5, len(sorted) / 2, len(sorted), len(sorted) + 3} {
+			values := slices.Clone(input.Values)
+			PartialSort(values, k, sorttest.Compare)
+			for i := 0; i < min(k, len(values)); i++ {
+				if values[i].Key != sorted[i].Key {
+					t.Fatalf("%s k=%d: Got %v at %d expected %v", input.Name, k, values[i], i, sorted[i])
+				}
+			}
+			sorttest.Check(t, input.Name, input.Values, sortedCopy(values), false)
+		}
+	}
+}
+
+func TestObserved(t *testing.T) {
+	for _, input := range sorttest.Inputs() {
+		for _, k := range []int{0, len(input.Values) / 2, len(input.Values) - 1} {
+			values := slices.Clone(input.Values)
+			trace := observe.NewTrace()
+			SelectObserved(values, k, sorttest.Compare, trace)
+			sorttest.CheckTrace(t, input.Name, input.Values, values, trace)
+
+			values = slices.Clone(input.Values)
+			trace.Clear()
+			PartialSortObserved(values, k, sorttest.Compare, trace)
+			sorttest.CheckTrace(t, input.Name, input.Values, values, trace)
+		}
+	}
+}
+
+func TestTopK(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	top := NewTopK(5, cmp.Compare[int])
+	if _, ok := top.Threshold(); ok != false {
+		t.Errorf("Got %v expected %v", ok, false)
+	}
+	all := []int{}
+	for i := 0; i < 1000; i++ {
+		value := random.Intn(500)
+		all = append(all, value)
+		top.Push(value)
+		if actualValue := top.GetSize(); actualValue > 5 {
+			t.Fatalf("Got %v expected at most %v", actualValue, 5)
+		}
+	}
+	slices.Sort(all)
+	slices.Reverse(all)
+	if actualValue, expectedValue := top.Values(), all[:5]; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, _ := top.Threshold(); actualValue != all[4] {
+		t.Errorf("Got %v expected %v", actualValue, all[4])
+	}
+
+	top.Clear()
+	top.Push(3, 1, 2)
+	if actualValue, expectedValue := top.String(), "TopK\n3, 2, 1"; actualValue != expectedValue {
+		t.Errorf("Got %q expected %q", actualValue, expectedValue)
+	}
+}
+
+func TestTopKSmallest(t *testing.T) {
+	smallest := Largest([]int{5, 3, 9, 1, 7}, 2, func(a, b int) int { return cmp.Compare(b, a) })
+	if actualValue, expectedValue := smallest, []int{1, 3}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := Largest([]int{5, 3}, 0, cmp.Compare[int]); len(actualValue) != 0 {
+		t.Errorf("Got %v expected %v", actualValue, []int{})
+	}
+}
+
+func TestLargestOf(t *testing.T) {
+	single := linkedlist.New[int]()
+	single.Append(4, 8, 1, 9, 3)
+	if actualValue, expectedValue := LargestOf(single, 3, cmp.Compare[int]), []int{9, 8, 4}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	double := doublelinkedlist.New[string]()
+	double.Append("b", "d", "a")
+	if actualValue, expectedValue := LargestOf(double, 5, cmp.Compare[string]), []string{"d", "b", "a"}; !slices.Equal(actualValue, expectedValue) {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func BenchmarkSelect(b *testing.B) {
+	random := rand.New(rand.NewSource(1))
+	input := make([]int, 100000)
+	for i := range input {
+		input[i] = random.Int()
+	}
+	values := make([]int, len(input))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		copy(values, input)
+		Select(values, len(values)/2, cmp.Compare[int])
+	}
+}
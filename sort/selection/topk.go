@@ -0,0 +1,91 @@
+package selection
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/TranThang-2804/golangds/list"
+	"github.com/TranThang-2804/golangds/queue/priorityqueue"
+	"github.com/TranThang-2804/golangds/sort/heapsort"
+)
+
+// TopK keeps the k largest values pushed so far in a min-heap of size k,
+// so a stream of n values is processed in O(n log k) time and O(k) space.
+// Pass a reversed comparator to keep the k smallest values instead.
+type TopK[T any] struct {
+	k          int
+	comparator list.Comparator[T]
+	heap       *priorityqueue.PriorityQueue[T]
+}
+
+// NewTopK creates an empty TopK keeping the k largest values
+func NewTopK[T any](k int, comparator list.Comparator[T]) *TopK[T] {
+	return &TopK[T]{k: max(k, 0), comparator: comparator, heap: priorityqueue.New(comparator)}
+}
+
+// Push offers values to the TopK, a value smaller than or equal to the
+// k values kept is dropped
+func (t *TopK[T]) Push(values ...T) {
+	for _, value := range values {
+		if t.heap.Size() < t.k {
+			t.heap.Enqueue(value)
+			continue
+		}
+		if smallest, ok := t.heap.Peek(); ok && t.comparator(value, smallest) > 0 {
+			t.heap.Dequeue()
+			t.heap.Enqueue(value)
+		}
+	}
+}
+
+// Threshold returns the smallest of the values kept, the value a new value
+// must exceed to be kept once k values are kept
+// return false if no value is kept
+func (t *TopK[T]) Threshold() (T, bool) {
+	return t.heap.Peek()
+}
+
+// Values returns the values kept, largest first
+func (t *TopK[T]) Values() []T {
+	values := t.heap.Values()
+	heapsort.Sort(values, func(a, b T) int { return t.comparator(b, a) })
+	return values
+}
+
+// Get the number of values kept, at most k
+func (t *TopK[T]) GetSize() int {
+	return t.heap.Size()
+}
+
+// Check if no value is kept
+func (t *TopK[T]) IsEmpty() bool {
+	return t.heap.IsEmpty()
+}
+
+// Clear drops the values kept
+func (t *TopK[T]) Clear() {
+	t.heap.Clear()
+}
+
+// String returns the values kept, largest first
+func (t *TopK[T]) String() string {
+	values := []string{}
+	for _, value := range t.Values() {
+		values = append(values, fmt.Sprintf("%v", value))
+	}
+	return "TopK\n" + strings.Join(values, ", ")
+}
+
+// Largest returns the k largest values, largest first
+func Largest[T any](values []T, k int, comparator list.Comparator[T]) []T {
+	top := NewTopK(k, comparator)
+	top.Push(values...)
+	return top.Values()
+}
+
+// LargestOf returns the k largest values of a list, largest first. The list
+// is read in one pass with GetAllNode rather than by index, which would take
+// quadratic time on linked lists.
+func LargestOf[T any](l list.List[T], k int, comparator list.Comparator[T]) []T {
+	return Largest(l.GetAllNode(), k, comparator)
+}
@@ -8,13 +8,14 @@ import (
 )
 
 // This is the stack implementation using linked list
-type Stack[T comparable] struct {
+type Stack[T any] struct {
 	list *linkedlist.LinkedList[T]
 }
 
-// Constructor for creating linkedlist stack
-func New[T comparable]() *Stack[T] {
-	return &Stack[T]{list: linkedlist.New[T]()}
+// Constructor for creating linkedlist stack, of items of any type as the
+// stack never compares them
+func New[T any]() *Stack[T] {
+	return &Stack[T]{list: linkedlist.NewFunc[T](nil)}
 }
 
 // Push the items into the stack
@@ -65,6 +66,11 @@ func (s *Stack[T]) IsEmpty() bool {
 	return s.list.IsEmpty()
 }
 
+// Clone returns a new stack with the items of the stack
+func (s *Stack[T]) Clone() *Stack[T] {
+	return &Stack[T]{list: s.list.Clone()}
+}
+
 // Return the string representation of the stack
 func (s *Stack[T]) String() string {
 	str := "LinkedListStack\n"
@@ -9,8 +9,15 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+
+	stackpkg "github.com/TranThang-2804/golangds/stack"
 )
 
+func TestStackImplementsInterface(t *testing.T) {
+	var _ stackpkg.Stack[int] = New[int]()
+	var _ stackpkg.Stack[[]int] = New[[]int]()
+}
+
 func TestStackPush(t *testing.T) {
 	stack := New[int]()
 	if actualValue := stack.IsEmpty(); actualValue != true {
@@ -249,6 +256,33 @@ func TestStackString(t *testing.T) {
 	}
 }
 
+func TestStackClone(t *testing.T) {
+	stack := New[int]()
+	stack.Push(1)
+	stack.Push(2)
+	clone := stack.Clone()
+	clone.Push(3)
+	stack.Pop()
+	if actualValue, expectedValue := fmt.Sprint(stack.Values()), "[1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprint(clone.Values()), "[3 2 1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestStackAnyType(t *testing.T) {
+	stack := New[map[string]int]()
+	stack.Push(map[string]int{"a": 1})
+	stack.Push(map[string]int{"b": 2})
+	if actualValue, ok := stack.Pop(); actualValue["b"] != 2 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, map[string]int{"b": 2})
+	}
+	if actualValue, expectedValue := stack.Size(), 1; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
 func benchmarkPush(b *testing.B, stack *Stack[int], size int) {
 	for i := 0; i < b.N; i++ {
 		for n := 0; n < size; n++ {